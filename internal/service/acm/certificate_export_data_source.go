@@ -0,0 +1,70 @@
+package acm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_acm_certificate_export")
+func DataSourceCertificateExport() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCertificateExportRead,
+
+		Schema: map[string]*schema.Schema{
+			"certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"certificate_chain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"passphrase": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(4, 128),
+			},
+			"private_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceCertificateExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ACMConn()
+
+	arn := d.Get("certificate_arn").(string)
+	input := &acm.ExportCertificateInput{
+		CertificateArn: aws.String(arn),
+		Passphrase:     []byte(d.Get("passphrase").(string)),
+	}
+
+	output, err := conn.ExportCertificateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("exporting ACM Certificate (%s): %s", arn, err)
+	}
+
+	d.SetId(arn)
+	d.Set("certificate", output.Certificate)
+	d.Set("certificate_chain", output.CertificateChain)
+	d.Set("private_key", output.PrivateKey)
+
+	return nil
+}
@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -41,51 +44,78 @@ func ResourceCertificateValidation() *schema.Resource {
 				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"validation_record_fqdns_strict": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
 		},
+
+		CustomizeDiff: resourceCertificateValidationCustomizeDiff,
 	}
 }
 
-func resourceCertificateValidationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceCertificateValidationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Strict checking of validation_record_fqdns happens at plan time when the certificate already exists.
+	if d.Id() != "" || !d.Get("validation_record_fqdns_strict").(bool) {
+		return nil
+	}
+
+	if !d.NewValueKnown("certificate_arn") || !d.NewValueKnown("validation_record_fqdns") {
+		return nil
+	}
+
+	v, ok := d.GetOk("validation_record_fqdns")
+
+	if !ok || v.(*schema.Set).Len() == 0 {
+		return nil
+	}
+
 	conn := meta.(*conns.AWSClient).ACMConn()
 
 	arn := d.Get("certificate_arn").(string)
 	certificate, err := FindCertificateByARN(ctx, conn, arn)
 
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
 	if err != nil {
-		return diag.Errorf("reading ACM Certificate (%s): %s", arn, err)
+		return fmt.Errorf("reading ACM Certificate (%s): %w", arn, err)
 	}
 
-	if v := aws.StringValue(certificate.Type); v != acm.CertificateTypeAmazonIssued {
-		return diag.Errorf("ACM Certificate (%s) has type %s, no validation necessary", arn, v)
+	if aws.StringValue(certificate.Type) != acm.CertificateTypeAmazonIssued {
+		return nil
 	}
 
-	if v, ok := d.GetOk("validation_record_fqdns"); ok && v.(*schema.Set).Len() > 0 {
-		fqdns := make(map[string]*acm.DomainValidation)
+	// Domain validation records may not yet be available, defer to Create.
+	for _, domainValidation := range certificate.DomainValidationOptions {
+		if aws.StringValue(domainValidation.ValidationMethod) == acm.ValidationMethodDns && domainValidation.ResourceRecord == nil {
+			return nil
+		}
+	}
 
-		for _, domainValidation := range certificate.DomainValidationOptions {
-			if v := aws.StringValue(domainValidation.ValidationMethod); v != acm.ValidationMethodDns {
-				return diag.Errorf("validation_record_fqdns is not valid for %s validation", v)
-			}
+	return validateCertificateValidationRecordFQDNs(certificate, flex.ExpandStringValueSet(v.(*schema.Set)), true)
+}
 
-			if v := domainValidation.ResourceRecord; v != nil {
-				if v := aws.StringValue(v.Name); v != "" {
-					fqdns[strings.TrimSuffix(v, ".")] = domainValidation
-				}
-			}
-		}
+func resourceCertificateValidationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ACMConn()
 
-		for _, v := range v.(*schema.Set).List() {
-			delete(fqdns, strings.TrimSuffix(v.(string), "."))
-		}
+	arn := d.Get("certificate_arn").(string)
+	certificate, err := FindCertificateByARN(ctx, conn, arn)
 
-		if len(fqdns) > 0 {
-			var errs *multierror.Error
+	if err != nil {
+		return diag.Errorf("reading ACM Certificate (%s): %s", arn, err)
+	}
 
-			for fqdn, domainValidation := range fqdns {
-				errs = multierror.Append(errs, fmt.Errorf("missing %s DNS validation record: %s", aws.StringValue(domainValidation.DomainName), fqdn))
-			}
+	if v := aws.StringValue(certificate.Type); v != acm.CertificateTypeAmazonIssued {
+		return diag.Errorf("ACM Certificate (%s) has type %s, no validation necessary", arn, v)
+	}
 
-			return diag.FromErr(errs)
+	if v, ok := d.GetOk("validation_record_fqdns"); ok && v.(*schema.Set).Len() > 0 {
+		if err := validateCertificateValidationRecordFQDNs(certificate, flex.ExpandStringValueSet(v.(*schema.Set)), d.Get("validation_record_fqdns_strict").(bool)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -119,6 +149,47 @@ func resourceCertificateValidationRead(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
+// validateCertificateValidationRecordFQDNs checks that the specified FQDNs implement all of the certificate's DNS validation records.
+// In strict mode any FQDN that does not correspond to a DNS validation record is also an error.
+func validateCertificateValidationRecordFQDNs(certificate *acm.CertificateDetail, fqdns []string, strict bool) error {
+	expected := make(map[string]*acm.DomainValidation)
+
+	for _, domainValidation := range certificate.DomainValidationOptions {
+		if v := aws.StringValue(domainValidation.ValidationMethod); v != acm.ValidationMethodDns {
+			return fmt.Errorf("validation_record_fqdns is not valid for %s validation", v)
+		}
+
+		if v := domainValidation.ResourceRecord; v != nil {
+			if v := aws.StringValue(v.Name); v != "" {
+				expected[strings.TrimSuffix(v, ".")] = domainValidation
+			}
+		}
+	}
+
+	var errs *multierror.Error
+	missing := make(map[string]*acm.DomainValidation, len(expected))
+
+	for k, v := range expected {
+		missing[k] = v
+	}
+
+	for _, v := range fqdns {
+		fqdn := strings.TrimSuffix(v, ".")
+
+		if _, ok := expected[fqdn]; !ok && strict {
+			errs = multierror.Append(errs, fmt.Errorf("unknown DNS validation record: %s", fqdn))
+		}
+
+		delete(missing, fqdn)
+	}
+
+	for fqdn, domainValidation := range missing {
+		errs = multierror.Append(errs, fmt.Errorf("missing %s DNS validation record: %s", aws.StringValue(domainValidation.DomainName), fqdn))
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func FindCertificateValidationByARN(ctx context.Context, conn *acm.ACM, arn string) (*acm.CertificateDetail, error) {
 	output, err := FindCertificateByARN(ctx, conn, arn)
 
@@ -158,10 +229,25 @@ func statusCertificate(ctx context.Context, conn *acm.ACM, arn string) resource.
 }
 
 func waitCertificateIssued(ctx context.Context, conn *acm.ACM, arn string, timeout time.Duration) (*acm.CertificateDetail, error) {
+	// Capture the output of the final poll so that a timeout can report which domains are still pending validation.
+	var mu sync.Mutex
+	var lastOutput *acm.CertificateDetail
+	refresh := statusCertificate(ctx, conn, arn)
+
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{acm.CertificateStatusPendingValidation},
 		Target:  []string{acm.CertificateStatusIssued},
-		Refresh: statusCertificate(ctx, conn, arn),
+		Refresh: func() (interface{}, string, error) {
+			outputRaw, status, err := refresh()
+
+			if output, ok := outputRaw.(*acm.CertificateDetail); ok {
+				mu.Lock()
+				lastOutput = output
+				mu.Unlock()
+			}
+
+			return outputRaw, status, err
+		},
 		Timeout: timeout,
 	}
 
@@ -178,5 +264,37 @@ func waitCertificateIssued(ctx context.Context, conn *acm.ACM, arn string, timeo
 		return output, err
 	}
 
+	mu.Lock()
+	defer mu.Unlock()
+
+	if lastOutput != nil {
+		if pending := pendingDomainValidations(lastOutput); len(pending) > 0 {
+			tfresource.SetLastError(err, fmt.Errorf("domain validations pending: %s", strings.Join(pending, ", ")))
+		}
+	}
+
 	return nil, err
 }
+
+// pendingDomainValidations returns a description of each of the certificate's domain validations that are still pending.
+func pendingDomainValidations(certificate *acm.CertificateDetail) []string {
+	var pending []string
+
+	for _, domainValidation := range certificate.DomainValidationOptions {
+		if aws.StringValue(domainValidation.ValidationStatus) != acm.DomainStatusPendingValidation {
+			continue
+		}
+
+		v := aws.StringValue(domainValidation.DomainName)
+
+		if record := domainValidation.ResourceRecord; record != nil && aws.StringValue(domainValidation.ValidationMethod) == acm.ValidationMethodDns {
+			v = fmt.Sprintf("%s (%s %s)", v, aws.StringValue(record.Type), strings.TrimSuffix(aws.StringValue(record.Name), "."))
+		}
+
+		pending = append(pending, v)
+	}
+
+	sort.Strings(pending)
+
+	return pending
+}
@@ -0,0 +1,106 @@
+package acm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+)
+
+func TestValidateCertificateValidationRecordFQDNs(t *testing.T) {
+	t.Parallel()
+
+	certificate := &acm.CertificateDetail{
+		DomainValidationOptions: []*acm.DomainValidation{
+			{
+				DomainName:       aws.String("example.com"),
+				ResourceRecord:   &acm.ResourceRecord{Name: aws.String("_a.example.com."), Type: aws.String(acm.RecordTypeCname)},
+				ValidationMethod: aws.String(acm.ValidationMethodDns),
+			},
+			{
+				DomainName:       aws.String("www.example.com"),
+				ResourceRecord:   &acm.ResourceRecord{Name: aws.String("_b.www.example.com."), Type: aws.String(acm.RecordTypeCname)},
+				ValidationMethod: aws.String(acm.ValidationMethodDns),
+			},
+		},
+	}
+
+	testCases := []struct {
+		TestName    string
+		FQDNs       []string
+		Strict      bool
+		ExpectError bool
+	}{
+		{
+			TestName: "all records",
+			FQDNs:    []string{"_a.example.com", "_b.www.example.com."},
+		},
+		{
+			TestName:    "missing record",
+			FQDNs:       []string{"_a.example.com"},
+			ExpectError: true,
+		},
+		{
+			TestName: "extra record",
+			FQDNs:    []string{"_a.example.com", "_b.www.example.com", "_c.example.com"},
+		},
+		{
+			TestName:    "extra record strict",
+			FQDNs:       []string{"_a.example.com", "_b.www.example.com", "_c.example.com"},
+			Strict:      true,
+			ExpectError: true,
+		},
+		{
+			TestName: "all records strict",
+			FQDNs:    []string{"_a.example.com.", "_b.www.example.com"},
+			Strict:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateCertificateValidationRecordFQDNs(certificate, testCase.FQDNs, testCase.Strict)
+
+			if got, want := err != nil, testCase.ExpectError; got != want {
+				t.Errorf("got error %v, expected error: %t", err, want)
+			}
+		})
+	}
+}
+
+func TestPendingDomainValidations(t *testing.T) {
+	t.Parallel()
+
+	certificate := &acm.CertificateDetail{
+		DomainValidationOptions: []*acm.DomainValidation{
+			{
+				DomainName:       aws.String("www.example.com"),
+				ResourceRecord:   &acm.ResourceRecord{Name: aws.String("_b.www.example.com."), Type: aws.String(acm.RecordTypeCname)},
+				ValidationMethod: aws.String(acm.ValidationMethodDns),
+				ValidationStatus: aws.String(acm.DomainStatusPendingValidation),
+			},
+			{
+				DomainName:       aws.String("example.com"),
+				ResourceRecord:   &acm.ResourceRecord{Name: aws.String("_a.example.com."), Type: aws.String(acm.RecordTypeCname)},
+				ValidationMethod: aws.String(acm.ValidationMethodDns),
+				ValidationStatus: aws.String(acm.DomainStatusSuccess),
+			},
+			{
+				DomainName:       aws.String("api.example.com"),
+				ValidationMethod: aws.String(acm.ValidationMethodEmail),
+				ValidationStatus: aws.String(acm.DomainStatusPendingValidation),
+			},
+		},
+	}
+
+	got := pendingDomainValidations(certificate)
+	want := []string{"api.example.com", "www.example.com (CNAME _b.www.example.com)"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, expected %v", got, want)
+	}
+}
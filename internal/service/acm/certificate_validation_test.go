@@ -53,7 +53,7 @@ func TestAccACMCertificateValidation_timeout(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccCertificateValidationConfig_timeout(domain),
-				ExpectError: regexp.MustCompile(`timeout while waiting for state to become 'ISSUED' \(last state: 'PENDING_VALIDATION'`),
+				ExpectError: regexp.MustCompile(`timeout while waiting for state to become 'ISSUED' \(last state: 'PENDING_VALIDATION', timeout: 5s\): domain validations pending: `),
 			},
 		},
 	})
@@ -89,6 +89,36 @@ func TestAccACMCertificateValidation_validationRecordFQDNS(t *testing.T) {
 	})
 }
 
+func TestAccACMCertificateValidation_validationRecordFQDNSStrict(t *testing.T) {
+	ctx := acctest.Context(t)
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	certificateResourceName := "aws_acm_certificate.test"
+	resourceName := "aws_acm_certificate_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, acm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			// Test that validation fails if given validation_fqdns include an unknown FQDN
+			{
+				Config:      testAccCertificateValidationConfig_recordFQDNsStrictExtraFQDN(rootDomain, domain),
+				ExpectError: regexp.MustCompile("unknown DNS validation record: extra-validation-fqdn.example.com"),
+			},
+			{
+				Config: testAccCertificateValidationConfig_recordFQDNsStrict(rootDomain, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateValidationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_arn", certificateResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "validation_record_fqdns_strict", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccACMCertificateValidation_validationRecordFQDNSEmail(t *testing.T) {
 	ctx := acctest.Context(t)
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
@@ -382,6 +412,49 @@ resource "aws_acm_certificate_validation" "test" {
 `, domainName, rootZoneDomain)
 }
 
+func testAccCertificateValidationConfig_recordFQDNsStrictBase(rootZoneDomain, domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  domain_name       = %[1]q
+  validation_method = "DNS"
+}
+
+data "aws_route53_zone" "test" {
+  name         = %[2]q
+  private_zone = false
+}
+
+resource "aws_route53_record" "test" {
+  allow_overwrite = true
+  name            = tolist(aws_acm_certificate.test.domain_validation_options)[0].resource_record_name
+  records         = [tolist(aws_acm_certificate.test.domain_validation_options)[0].resource_record_value]
+  ttl             = 60
+  type            = tolist(aws_acm_certificate.test.domain_validation_options)[0].resource_record_type
+  zone_id         = data.aws_route53_zone.test.zone_id
+}
+`, domainName, rootZoneDomain)
+}
+
+func testAccCertificateValidationConfig_recordFQDNsStrict(rootZoneDomain, domainName string) string {
+	return acctest.ConfigCompose(testAccCertificateValidationConfig_recordFQDNsStrictBase(rootZoneDomain, domainName), `
+resource "aws_acm_certificate_validation" "test" {
+  certificate_arn                = aws_acm_certificate.test.arn
+  validation_record_fqdns        = [aws_route53_record.test.fqdn]
+  validation_record_fqdns_strict = true
+}
+`)
+}
+
+func testAccCertificateValidationConfig_recordFQDNsStrictExtraFQDN(rootZoneDomain, domainName string) string {
+	return acctest.ConfigCompose(testAccCertificateValidationConfig_recordFQDNsStrictBase(rootZoneDomain, domainName), `
+resource "aws_acm_certificate_validation" "test" {
+  certificate_arn                = aws_acm_certificate.test.arn
+  validation_record_fqdns        = [aws_route53_record.test.fqdn, "extra-validation-fqdn.example.com"]
+  validation_record_fqdns_strict = true
+}
+`)
+}
+
 func testAccCertificateValidationConfig_recordFQDNsTwoRoute53Records(rootZoneDomain, domainName, subjectAlternativeNames string) string {
 	return fmt.Sprintf(`
 resource "aws_acm_certificate" "test" {
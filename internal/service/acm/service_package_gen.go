@@ -25,6 +25,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceCertificate,
 			TypeName: "aws_acm_certificate",
 		},
+		{
+			Factory:  DataSourceCertificateExport,
+			TypeName: "aws_acm_certificate_export",
+		},
 	}
 }
 
@@ -34,3 +34,27 @@ func FindStageByName(ctx context.Context, conn *apigateway.APIGateway, restApiId
 
 	return output, nil
 }
+
+func FindRestAPIByID(ctx context.Context, conn *apigateway.APIGateway, id string) (*apigateway.RestApi, error) {
+	input := &apigateway.GetRestApiInput{
+		RestApiId: aws.String(id),
+	}
+
+	output, err := conn.GetRestApiWithContext(ctx, input)
+	if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -2,6 +2,8 @@ package apigateway
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"strconv"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -57,6 +60,10 @@ func ResourceRestAPI() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"body_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"created_date": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -105,6 +112,10 @@ func ResourceRestAPI() *schema.Resource {
 					},
 				},
 			},
+			"fail_on_warnings": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"minimum_compression_size": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -152,8 +163,39 @@ func ResourceRestAPI() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceRestAPIBodyHashCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceRestAPIBodyHashCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("body") {
+		return nil
+	}
+
+	if !d.NewValueKnown("body") {
+		return d.SetNewComputed("body_hash")
+	}
+
+	return d.SetNew("body_hash", restAPIBodyHash(d.Get("body").(string)))
+}
+
+// restAPIBodyHash returns the hex-encoded SHA256 hash of an OpenAPI specification.
+// JSON specifications are normalized first so that formatting-only changes don't change the hash.
+func restAPIBodyHash(body string) string {
+	if body == "" {
+		return ""
+	}
+
+	if v, err := structure.NormalizeJsonString(body); err == nil {
+		body = v
 	}
+
+	hash := sha256.Sum256([]byte(body))
+
+	return hex.EncodeToString(hash[:])
 }
 
 func resourceRestAPICreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -219,6 +261,10 @@ func resourceRestAPICreate(ctx context.Context, d *schema.ResourceData, meta int
 			Body: []byte(body.(string)),
 		}
 
+		if v, ok := d.GetOk("fail_on_warnings"); ok {
+			input.FailOnWarnings = aws.Bool(v.(bool))
+		}
+
 		if v, ok := d.GetOk("parameters"); ok && len(v.(map[string]interface{})) > 0 {
 			input.Parameters = flex.ExpandStringMap(v.(map[string]interface{}))
 		}
@@ -229,6 +275,8 @@ func resourceRestAPICreate(ctx context.Context, d *schema.ResourceData, meta int
 			return sdkdiag.AppendErrorf(diags, "creating API Gateway specification: %s", err)
 		}
 
+		diags = appendRestAPIWarnings(diags, d.Id(), output)
+
 		// Using PutRestApi with mode overwrite will remove any configuration
 		// that was done with CreateRestApi. Reconcile these changes by having
 		// any Terraform configured values overwrite imported configuration.
@@ -317,6 +365,7 @@ func resourceRestAPIRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("policy", policyToSet)
 
 	d.Set("binary_media_types", api.BinaryMediaTypes)
+	d.Set("body_hash", restAPIBodyHash(d.Get("body").(string)))
 
 	execution_arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
@@ -471,6 +520,40 @@ func resourceRestAPIWithBodyUpdateOperations(d *schema.ResourceData, output *api
 	return operations
 }
 
+// appendRestAPIPolicyRestoreOperation restores a policy removed by an OpenAPI import,
+// unless the operations already set the policy.
+func appendRestAPIPolicyRestoreOperation(operations []*apigateway.PatchOperation, policy string) ([]*apigateway.PatchOperation, error) {
+	if policy == "" {
+		return operations, nil
+	}
+
+	for _, v := range operations {
+		if aws.StringValue(v.Path) == "/policy" {
+			return operations, nil
+		}
+	}
+
+	// The API returns policy as an escaped JSON string, see resourceRestAPIRead.
+	normalizedPolicy, err := structure.NormalizeJsonString(`"` + policy + `"`)
+
+	if err != nil {
+		return nil, fmt.Errorf("normalizing policy JSON: %w", err)
+	}
+
+	policy, err = strconv.Unquote(normalizedPolicy)
+
+	if err != nil {
+		return nil, fmt.Errorf("unescaping policy: %w", err)
+	}
+
+	log.Printf("[DEBUG] Restoring API Gateway REST API policy removed by OpenAPI import")
+	return append(operations, &apigateway.PatchOperation{
+		Op:    aws.String(apigateway.OpReplace),
+		Path:  aws.String("/policy"),
+		Value: aws.String(policy),
+	}), nil
+}
+
 func resourceRestAPIUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).APIGatewayConn()
@@ -628,16 +711,30 @@ func resourceRestAPIUpdate(ctx context.Context, d *schema.ResourceData, meta int
 				Body: []byte(body.(string)),
 			}
 
+			if v, ok := d.GetOk("fail_on_warnings"); ok {
+				input.FailOnWarnings = aws.Bool(v.(bool))
+			}
+
 			if v, ok := d.GetOk("parameters"); ok && len(v.(map[string]interface{})) > 0 {
 				input.Parameters = flex.ExpandStringMap(v.(map[string]interface{}))
 			}
 
+			// Capture any policy managed outside of this resource, e.g. by aws_api_gateway_rest_api_policy,
+			// so that it can be restored if the specification doesn't include one.
+			previous, err := FindRestAPIByID(ctx, conn, d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading API Gateway REST API (%s): %s", d.Id(), err)
+			}
+
 			output, err := conn.PutRestApiWithContext(ctx, input)
 
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating API Gateway specification: %s", err)
 			}
 
+			diags = appendRestAPIWarnings(diags, d.Id(), output)
+
 			// Using PutRestApi with mode overwrite will remove any configuration
 			// that was done previously. Reconcile these changes by having
 			// any Terraform configured values overwrite imported configuration.
@@ -648,6 +745,16 @@ func resourceRestAPIUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 			updateInput.PatchOperations = resourceRestAPIWithBodyUpdateOperations(d, output)
 
+			if d.GetRawConfig().GetAttr("policy").IsNull() && aws.StringValue(output.Policy) == "" {
+				operations, err := appendRestAPIPolicyRestoreOperation(updateInput.PatchOperations, aws.StringValue(previous.Policy))
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "restoring API Gateway REST API (%s) policy: %s", d.Id(), err)
+				}
+
+				updateInput.PatchOperations = operations
+			}
+
 			if len(updateInput.PatchOperations) > 0 {
 				_, err := conn.UpdateRestApiWithContext(ctx, updateInput)
 
@@ -668,6 +775,19 @@ func resourceRestAPIUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	return append(diags, resourceRestAPIRead(ctx, d, meta)...)
 }
 
+// appendRestAPIWarnings returns the warnings from importing an OpenAPI specification as diagnostics.
+func appendRestAPIWarnings(diags diag.Diagnostics, id string, output *apigateway.RestApi) diag.Diagnostics {
+	if output == nil {
+		return diags
+	}
+
+	for _, v := range output.Warnings {
+		diags = sdkdiag.AppendWarningf(diags, "API Gateway REST API (%s) OpenAPI import: %s", id, aws.StringValue(v))
+	}
+
+	return diags
+}
+
 func modeConfigOrDefault(d *schema.ResourceData) string {
 	if v, ok := d.GetOk("put_rest_api_mode"); ok {
 		return v.(string)
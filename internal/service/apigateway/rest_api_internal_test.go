@@ -0,0 +1,90 @@
+package apigateway
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestRestAPIWithBodyPolicyOperations(t *testing.T) {
+	t.Parallel()
+
+	policy := `{"Statement":[{"Action":"execute-api:Invoke","Effect":"Allow","Principal":"*","Resource":"*"}],"Version":"2012-10-17"}`
+	// The API returns policy as an escaped JSON string.
+	previousPolicy := `{\"Statement\":[{\"Action\":\"execute-api:Invoke\",\"Effect\":\"Allow\",\"Principal\":\"*\",\"Resource\":\"*\"}],\"Version\":\"2012-10-17\"}`
+
+	testCases := map[string]struct {
+		raw            map[string]interface{}
+		previousPolicy string
+		expectedPolicy string
+	}{
+		"policy in state": {
+			raw: map[string]interface{}{
+				"name":   "test",
+				"policy": policy,
+			},
+			previousPolicy: previousPolicy,
+			expectedPolicy: policy,
+		},
+		"policy not in state": {
+			raw: map[string]interface{}{
+				"name": "test",
+			},
+			previousPolicy: previousPolicy,
+			expectedPolicy: policy,
+		},
+		"no previous policy": {
+			raw: map[string]interface{}{
+				"name": "test",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, ResourceRestAPI().Schema, testCase.raw)
+			output := &apigateway.RestApi{
+				Name: aws.String("test"),
+			}
+
+			operations, err := appendRestAPIPolicyRestoreOperation(resourceRestAPIWithBodyUpdateOperations(d, output), testCase.previousPolicy)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var policyOperations []*apigateway.PatchOperation
+			for _, v := range operations {
+				if aws.StringValue(v.Path) == "/policy" {
+					policyOperations = append(policyOperations, v)
+				}
+			}
+
+			if testCase.expectedPolicy == "" {
+				if len(policyOperations) != 0 {
+					t.Fatalf("expected no /policy operations, got %d", len(policyOperations))
+				}
+
+				return
+			}
+
+			if len(policyOperations) != 1 {
+				t.Fatalf("expected 1 /policy operation, got %d", len(policyOperations))
+			}
+
+			if got, want := aws.StringValue(policyOperations[0].Op), apigateway.OpReplace; got != want {
+				t.Errorf("expected op %q, got %q", want, got)
+			}
+
+			if got, want := aws.StringValue(policyOperations[0].Value), testCase.expectedPolicy; got != want {
+				t.Errorf("expected policy %s, got %s", want, got)
+			}
+		})
+	}
+}
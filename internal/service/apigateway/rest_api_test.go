@@ -518,6 +518,7 @@ func TestAccAPIGatewayRestAPI_body(t *testing.T) {
 					testAccCheckRestAPIRoutes(ctx, &conf, []string{"/", "/test"}),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "body_hash"),
 					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
 					resource.TestCheckResourceAttrSet(resourceName, "execution_arn"),
 				),
@@ -534,6 +535,7 @@ func TestAccAPIGatewayRestAPI_body(t *testing.T) {
 					testAccCheckRestAPIExists(ctx, resourceName, &conf),
 					testAccCheckRestAPIRoutes(ctx, &conf, []string{"/", "/update"}),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "body_hash"),
 					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
 					resource.TestCheckResourceAttrSet(resourceName, "execution_arn"),
 				),
@@ -1361,6 +1363,75 @@ func TestAccAPIGatewayRestAPI_Policy_setByBody(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayRestAPI_Policy_preservedOnBodyUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf apigateway.RestApi
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_rest_api.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRestAPIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestAPIConfig_policySetByPolicyResource(rName, "/test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRestAPIExists(ctx, resourceName, &conf),
+					testAccCheckRestAPIRoutes(ctx, &conf, []string{"/", "/test"}),
+				),
+			},
+			// Verify updated body doesn't remove the policy set by aws_api_gateway_rest_api_policy
+			{
+				Config: testAccRestAPIConfig_policySetByPolicyResource(rName, "/test2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRestAPIExists(ctx, resourceName, &conf),
+					testAccCheckRestAPIRoutes(ctx, &conf, []string{"/", "/test2"}),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"Deny"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayRestAPI_failOnWarnings(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf apigateway.RestApi
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_rest_api.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRestAPIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestAPIConfig_failOnWarnings(rName, "/test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRestAPIExists(ctx, resourceName, &conf),
+					testAccCheckRestAPIRoutes(ctx, &conf, []string{"/", "/test"}),
+					resource.TestCheckResourceAttr(resourceName, "fail_on_warnings", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"body", "fail_on_warnings", "put_rest_api_mode"},
+			},
+			{
+				Config: testAccRestAPIConfig_failOnWarnings(rName, "/update"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRestAPIExists(ctx, resourceName, &conf),
+					testAccCheckRestAPIRoutes(ctx, &conf, []string{"/", "/update"}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRestAPIRoutes(ctx context.Context, conf *apigateway.RestApi, routes []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
@@ -2654,3 +2725,62 @@ resource "aws_api_gateway_rest_api" "test" {
 }
 `, rName, bodyPolicyEffect)
 }
+
+func testAccRestAPIConfig_policySetByPolicyResource(rName string, bodyPath string) string {
+	return acctest.ConfigCompose(testAccRestAPIConfig_body(rName, bodyPath), `
+resource "aws_api_gateway_rest_api_policy" "test" {
+  rest_api_id = aws_api_gateway_rest_api.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Deny"
+      Principal = {
+        AWS = "*"
+      }
+      Action   = "execute-api:Invoke"
+      Resource = aws_api_gateway_rest_api.test.arn
+    }]
+  })
+}
+`)
+}
+
+func testAccRestAPIConfig_failOnWarnings(rName string, basePath string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name             = %[1]q
+  fail_on_warnings = true
+
+  body = jsonencode({
+    swagger = "2.0"
+    info = {
+      title   = "test"
+      version = "2017-04-20T04:08:08Z"
+    }
+    schemes = ["https"]
+    paths = {
+      %[2]q = {
+        get = {
+          responses = {
+            "200" = {
+              description = "OK"
+            }
+          }
+          x-amazon-apigateway-integration = {
+            httpMethod = "GET"
+            type       = "HTTP"
+            responses = {
+              default = {
+                statusCode = 200
+              }
+            }
+            uri = "https://api.example.com/"
+          }
+        }
+      }
+    }
+  })
+}
+`, rName, basePath)
+}
@@ -146,6 +146,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceUsagePlanKey,
 			TypeName: "aws_api_gateway_usage_plan_key",
 		},
+		{
+			Factory:  ResourceUsagePlanKeys,
+			TypeName: "aws_api_gateway_usage_plan_keys",
+		},
 		{
 			Factory:  ResourceVPCLink,
 			TypeName: "aws_api_gateway_vpc_link",
@@ -58,8 +58,9 @@ func ResourceUsagePlan() *schema.Resource {
 										Optional: true,
 									},
 									"path": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validUsagePlanThrottlePath,
 									},
 									"rate_limit": {
 										Type:     schema.TypeFloat,
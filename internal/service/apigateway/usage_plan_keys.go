@@ -0,0 +1,215 @@
+package apigateway
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	usagePlanKeyTypeAPIKey = "API_KEY"
+)
+
+// @SDKResource("aws_api_gateway_usage_plan_keys")
+func ResourceUsagePlanKeys() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUsagePlanKeysCreate,
+		ReadWithoutTimeout:   resourceUsagePlanKeysRead,
+		UpdateWithoutTimeout: resourceUsagePlanKeysUpdate,
+		DeleteWithoutTimeout: resourceUsagePlanKeysDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      usagePlanKeyTypeAPIKey,
+				ValidateFunc: validation.StringInSlice([]string{usagePlanKeyTypeAPIKey}, false),
+			},
+			"usage_plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUsagePlanKeysCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
+
+	usagePlanID := d.Get("usage_plan_id").(string)
+
+	// Set the ID before attaching any keys so that partially attached keys are tracked, and cleaned up, on failure.
+	d.SetId(usagePlanID)
+
+	diags = append(diags, createUsagePlanKeys(ctx, conn, usagePlanID, d.Get("key_type").(string), flex.ExpandStringValueSet(d.Get("key_ids").(*schema.Set)))...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceUsagePlanKeysRead(ctx, d, meta)...)
+}
+
+func resourceUsagePlanKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
+
+	keys, err := FindUsagePlanKeysByUsagePlanID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] API Gateway Usage Plan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway Usage Plan (%s) Keys: %s", d.Id(), err)
+	}
+
+	var keyIDs []string
+	for _, v := range keys {
+		keyIDs = append(keyIDs, aws.StringValue(v.Id))
+	}
+
+	d.Set("key_ids", keyIDs)
+	if len(keys) > 0 {
+		d.Set("key_type", keys[0].Type)
+	}
+	d.Set("usage_plan_id", d.Id())
+
+	return diags
+}
+
+func resourceUsagePlanKeysUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
+
+	if d.HasChange("key_ids") {
+		o, n := d.GetChange("key_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+
+		diags = append(diags, deleteUsagePlanKeys(ctx, conn, d.Id(), del)...)
+		diags = append(diags, createUsagePlanKeys(ctx, conn, d.Id(), d.Get("key_type").(string), add)...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return append(diags, resourceUsagePlanKeysRead(ctx, d, meta)...)
+}
+
+func resourceUsagePlanKeysDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
+
+	return deleteUsagePlanKeys(ctx, conn, d.Id(), flex.ExpandStringValueSet(d.Get("key_ids").(*schema.Set)))
+}
+
+// createUsagePlanKeys attaches the specified keys to a usage plan.
+// Keys are not read back individually; the whole key set is read once afterwards.
+func createUsagePlanKeys(ctx context.Context, conn *apigateway.APIGateway, usagePlanID, keyType string, keyIDs []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, keyID := range keyIDs {
+		_, err := conn.CreateUsagePlanKeyWithContext(ctx, &apigateway.CreateUsagePlanKeyInput{
+			KeyId:       aws.String(keyID),
+			KeyType:     aws.String(keyType),
+			UsagePlanId: aws.String(usagePlanID),
+		})
+
+		if tfawserr.ErrMessageContains(err, apigateway.ErrCodeConflictException, "already exists") {
+			continue
+		}
+
+		if err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "creating API Gateway Usage Plan (%s) Key (%s): %s", usagePlanID, keyID, err)
+		}
+	}
+
+	return diags
+}
+
+// deleteUsagePlanKeys detaches the specified keys from a usage plan.
+func deleteUsagePlanKeys(ctx context.Context, conn *apigateway.APIGateway, usagePlanID string, keyIDs []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, keyID := range keyIDs {
+		log.Printf("[DEBUG] Deleting API Gateway Usage Plan (%s) Key: %s", usagePlanID, keyID)
+		_, err := conn.DeleteUsagePlanKeyWithContext(ctx, &apigateway.DeleteUsagePlanKeyInput{
+			KeyId:       aws.String(keyID),
+			UsagePlanId: aws.String(usagePlanID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "deleting API Gateway Usage Plan (%s) Key (%s): %s", usagePlanID, keyID, err)
+		}
+	}
+
+	return diags
+}
+
+func FindUsagePlanKeysByUsagePlanID(ctx context.Context, conn *apigateway.APIGateway, usagePlanID string) ([]*apigateway.UsagePlanKey, error) {
+	input := &apigateway.GetUsagePlanKeysInput{
+		UsagePlanId: aws.String(usagePlanID),
+	}
+	var output []*apigateway.UsagePlanKey
+
+	err := conn.GetUsagePlanKeysPagesWithContext(ctx, input, func(page *apigateway.GetUsagePlanKeysOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
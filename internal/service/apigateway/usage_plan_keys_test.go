@@ -0,0 +1,170 @@
+package apigateway_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapigateway "github.com/hashicorp/terraform-provider-aws/internal/service/apigateway"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAPIGatewayUsagePlanKeys_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var keys []*apigateway.UsagePlanKey
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	apiGatewayUsagePlanResourceName := "aws_api_gateway_usage_plan.test"
+	resourceName := "aws_api_gateway_usage_plan_keys.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsagePlanKeysDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsagePlanKeysConfig_basic(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsagePlanKeysExists(ctx, resourceName, &keys),
+					testAccCheckUsagePlanKeysCount(&keys, 10),
+					resource.TestCheckResourceAttr(resourceName, "key_ids.#", "10"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "key_ids.*", "aws_api_gateway_api_key.test.0", "id"),
+					resource.TestCheckResourceAttr(resourceName, "key_type", "API_KEY"),
+					resource.TestCheckResourceAttrPair(resourceName, "usage_plan_id", apiGatewayUsagePlanResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUsagePlanKeysConfig_basic(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsagePlanKeysExists(ctx, resourceName, &keys),
+					testAccCheckUsagePlanKeysCount(&keys, 3),
+					resource.TestCheckResourceAttr(resourceName, "key_ids.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayUsagePlanKeys_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var keys []*apigateway.UsagePlanKey
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_usage_plan_keys.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsagePlanKeysDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsagePlanKeysConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsagePlanKeysExists(ctx, resourceName, &keys),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfapigateway.ResourceUsagePlanKeys(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckUsagePlanKeysExists(ctx context.Context, n string, v *[]*apigateway.UsagePlanKey) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway Usage Plan ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
+
+		output, err := tfapigateway.FindUsagePlanKeysByUsagePlanID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = output
+
+		return nil
+	}
+}
+
+func testAccCheckUsagePlanKeysCount(v *[]*apigateway.UsagePlanKey, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if actual := len(*v); actual != expected {
+			return fmt.Errorf("expected %d API Gateway Usage Plan Keys, got %d", expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckUsagePlanKeysDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_api_gateway_usage_plan_keys" {
+				continue
+			}
+
+			output, err := tfapigateway.FindUsagePlanKeysByUsagePlanID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("API Gateway Usage Plan %s still has keys", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccUsagePlanKeysConfig_basic(rName string, count int) string {
+	return acctest.ConfigCompose(
+		testAccUsagePlanKeyBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_api_gateway_api_key" "test" {
+  count = 10
+
+  name = "%[1]s-${count.index}"
+}
+
+resource "aws_api_gateway_usage_plan" "test" {
+  name = %[1]q
+
+  api_stages {
+    api_id = aws_api_gateway_rest_api.test.id
+    stage  = aws_api_gateway_deployment.test.stage_name
+  }
+}
+
+resource "aws_api_gateway_usage_plan_keys" "test" {
+  key_ids       = slice(aws_api_gateway_api_key.test[*].id, 0, %[2]d)
+  usage_plan_id = aws_api_gateway_usage_plan.test.id
+}
+`, rName, count))
+}
@@ -2,6 +2,7 @@ package apigateway
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -46,3 +47,29 @@ func validUsagePlanQuotaSettings(v map[string]interface{}) (errors []error) {
 
 	return
 }
+
+// validUsagePlanThrottlePath validates a Usage Plan API stage method throttling path.
+// The path has the form "{resourcePath}/{httpMethod}", e.g. "/pets/GET", "//GET" for the root resource or "*/*".
+func validUsagePlanThrottlePath(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	i := strings.LastIndex(value, "/")
+
+	if i == -1 {
+		errors = append(errors, fmt.Errorf("%q (%s) must be of the form RESOURCE_PATH/HTTP_METHOD, e.g. /pets/GET", k, value))
+		return
+	}
+
+	resourcePath, method := value[:i], value[i+1:]
+
+	if resourcePath != "*" && !strings.HasPrefix(resourcePath, "/") {
+		errors = append(errors, fmt.Errorf("%q (%s) resource path must begin with \"/\" or be \"*\"", k, value))
+	}
+
+	if method != "*" {
+		_, es := validHTTPMethod()(method, k)
+		errors = append(errors, es...)
+	}
+
+	return
+}
@@ -80,3 +80,38 @@ func TestValidUsagePlanQuotaSettings(t *testing.T) {
 		}
 	}
 }
+
+func TestValidUsagePlanThrottlePath(t *testing.T) {
+	t.Parallel()
+
+	validPaths := []string{
+		"/pets/GET",
+		"/pets/{petId}/DELETE",
+		"//GET",
+		"/pets/ANY",
+		"/pets/*",
+		"*/*",
+	}
+	for _, v := range validPaths {
+		_, errors := validUsagePlanThrottlePath(v, "path")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid API Gateway Usage Plan throttle path: %q", v, errors)
+		}
+	}
+
+	invalidPaths := []string{
+		"",
+		"GET",
+		"/GET",
+		"pets/GET",
+		"/pets/get",
+		"/pets/FETCH",
+		"/pets/",
+	}
+	for _, v := range invalidPaths {
+		_, errors := validUsagePlanThrottlePath(v, "path")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid API Gateway Usage Plan throttle path", v)
+		}
+	}
+}
@@ -2,11 +2,14 @@ package apigatewayv2
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -26,6 +29,10 @@ func DataSourceExport() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"body_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"export_version": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -82,7 +89,31 @@ func dataSourceExportRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.SetId(apiId)
 
-	d.Set("body", string(export.Body))
+	body := string(export.Body)
+	bodySHA256, err := exportBodySHA256(body, d.Get("output_type").(string))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "exporting Gateway v2 API (%s): %s", apiId, err)
+	}
+
+	d.Set("body", body)
+	d.Set("body_sha256", bodySHA256)
 
 	return diags
 }
+
+// exportBodySHA256 returns the hex-encoded SHA256 hash of an exported API definition.
+// JSON definitions are normalized first so that the hash only changes when the API does.
+func exportBodySHA256(body, outputType string) (string, error) {
+	if outputType == "JSON" {
+		v, err := structure.NormalizeJsonString(body)
+		if err != nil {
+			return "", err
+		}
+
+		body = v
+	}
+
+	hash := sha256.Sum256([]byte(body))
+
+	return hex.EncodeToString(hash[:]), nil
+}
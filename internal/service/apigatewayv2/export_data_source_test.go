@@ -26,6 +26,7 @@ func TestAccAPIGatewayV2ExportDataSource_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceName, "api_id", "aws_apigatewayv2_route.test", "api_id"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "body"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "body_sha256"),
 				),
 			},
 		},
@@ -49,6 +50,7 @@ func TestAccAPIGatewayV2ExportDataSource_stage(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "api_id", "aws_apigatewayv2_route.test", "api_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "stage_name", "aws_apigatewayv2_stage.test", "name"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "body"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "body_sha256"),
 				),
 			},
 		},
@@ -3,6 +3,7 @@ package autoscaling
 import ( // nosemgrep:ci.aws-sdk-go-multiple-service-imports
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
@@ -113,6 +114,11 @@ func ResourceGroup() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"ignore_failed_scaling_activities": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"initial_lifecycle_hook": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -211,6 +217,11 @@ func ResourceGroup() *schema.Resource {
 								ValidateDiagFunc: validateGroupInstanceRefreshTriggerFields,
 							},
 						},
+						"wait_for_instance_refresh": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -873,6 +884,8 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
+	startTime := time.Now()
+
 	asgName := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
 	createInput := &autoscaling.CreateAutoScalingGroupInput{
 		AutoScalingGroupName:             aws.String(asgName),
@@ -1050,7 +1063,7 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 				return nil
 			}
 
-			if err := waitGroupCapacitySatisfied(ctx, conn, meta.(*conns.AWSClient).ELBConn(), meta.(*conns.AWSClient).ELBV2Conn(), d.Id(), f, v); err != nil {
+			if err := waitGroupCapacitySatisfied(ctx, conn, meta.(*conns.AWSClient).ELBConn(), meta.(*conns.AWSClient).ELBV2Conn(), d.Id(), f, startTime, d.Get("ignore_failed_scaling_activities").(bool), v); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Group (%s) capacity satisfied: %s", d.Id(), err)
 			}
 		}
@@ -1210,6 +1223,8 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
+	startTime := time.Now()
+
 	var shouldWaitForCapacity bool
 	var shouldRefreshInstances bool
 
@@ -1511,9 +1526,17 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 
 		if shouldRefreshInstances {
-			if err := startInstanceRefresh(ctx, conn, expandStartInstanceRefreshInput(d.Id(), tfMap)); err != nil {
+			instanceRefreshID, err := startInstanceRefresh(ctx, conn, expandStartInstanceRefreshInput(d.Id(), tfMap))
+
+			if err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
+
+			if v, ok := tfMap["wait_for_instance_refresh"].(bool); ok && v {
+				if _, err := waitInstanceRefreshSuccessful(ctx, conn, d.Id(), instanceRefreshID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Group (%s) instance refresh (%s): %s", d.Id(), instanceRefreshID, err)
+				}
+			}
 		}
 	}
 
@@ -1559,7 +1582,7 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 					return nil
 				}
 
-				if err := waitGroupCapacitySatisfied(ctx, conn, meta.(*conns.AWSClient).ELBConn(), meta.(*conns.AWSClient).ELBV2Conn(), d.Id(), f, v); err != nil {
+				if err := waitGroupCapacitySatisfied(ctx, conn, meta.(*conns.AWSClient).ELBConn(), meta.(*conns.AWSClient).ELBV2Conn(), d.Id(), f, startTime, d.Get("ignore_failed_scaling_activities").(bool), v); err != nil {
 					return sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Group (%s) capacity satisfied: %s", d.Id(), err)
 				}
 			}
@@ -2105,12 +2128,28 @@ func findScalingActivities(ctx context.Context, conn *autoscaling.AutoScaling, i
 	return output, nil
 }
 
-func findScalingActivitiesByName(ctx context.Context, conn *autoscaling.AutoScaling, name string) ([]*autoscaling.Activity, error) {
+func findScalingActivitiesByName(ctx context.Context, conn *autoscaling.AutoScaling, name string, startTime time.Time) ([]*autoscaling.Activity, error) {
 	input := &autoscaling.DescribeScalingActivitiesInput{
 		AutoScalingGroupName: aws.String(name),
 	}
 
-	return findScalingActivities(ctx, conn, input)
+	scalingActivities, err := findScalingActivities(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []*autoscaling.Activity
+
+	for _, v := range scalingActivities {
+		if aws.TimeValue(v.StartTime).Before(startTime) {
+			continue
+		}
+
+		output = append(output, v)
+	}
+
+	return output, nil
 }
 
 func findWarmPool(ctx context.Context, conn *autoscaling.AutoScaling, name string) (*autoscaling.DescribeWarmPoolOutput, error) {
@@ -2151,27 +2190,32 @@ func findWarmPool(ctx context.Context, conn *autoscaling.AutoScaling, name strin
 	return output, nil
 }
 
-func statusGroupCapacity(ctx context.Context, conn *autoscaling.AutoScaling, elbconn *elb.ELB, elbv2conn *elbv2.ELBV2, name string, cb func(int, int) error) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		// Check for fatal error in activity logs.
-		scalingActivities, err := findScalingActivitiesByName(ctx, conn, name)
+// failedScalingActivitiesError returns an error describing any of the specified scaling activities that have failed.
+func failedScalingActivitiesError(scalingActivities []*autoscaling.Activity) error {
+	var errors *multierror.Error
 
-		if err != nil {
-			return nil, "", fmt.Errorf("reading scaling activities: %w", err)
+	for _, v := range scalingActivities {
+		if statusCode := aws.StringValue(v.StatusCode); statusCode == autoscaling.ScalingActivityStatusCodeFailed && aws.Int64Value(v.Progress) == 100 {
+			errors = multierror.Append(errors, fmt.Errorf("Scaling activity (%s): %s: %s", aws.StringValue(v.ActivityId), statusCode, aws.StringValue(v.StatusMessage)))
 		}
+	}
+
+	return errors.ErrorOrNil()
+}
 
-		var errors *multierror.Error
+func statusGroupCapacity(ctx context.Context, conn *autoscaling.AutoScaling, elbconn *elb.ELB, elbv2conn *elbv2.ELBV2, name string, cb func(int, int) error, startTime time.Time, ignoreFailedScalingActivities bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		if !ignoreFailedScalingActivities {
+			// Check for fatal error in activity logs.
+			scalingActivities, err := findScalingActivitiesByName(ctx, conn, name, startTime)
 
-		for _, v := range scalingActivities {
-			if statusCode := aws.StringValue(v.StatusCode); statusCode == autoscaling.ScalingActivityStatusCodeFailed && aws.Int64Value(v.Progress) == 100 {
-				errors = multierror.Append(errors, fmt.Errorf("Scaling activity (%s): %s: %s", aws.StringValue(v.ActivityId), statusCode, aws.StringValue(v.StatusMessage)))
+			if err != nil {
+				return nil, "", fmt.Errorf("reading scaling activities: %w", err)
 			}
-		}
 
-		err = errors.ErrorOrNil()
-
-		if err != nil {
-			return nil, "", err
+			if err := failedScalingActivitiesError(scalingActivities); err != nil {
+				return nil, "", err
+			}
 		}
 
 		g, err := FindGroupByName(ctx, conn, name)
@@ -2373,17 +2417,20 @@ func statusWarmPoolInstanceCount(ctx context.Context, conn *autoscaling.AutoScal
 	}
 }
 
-func waitGroupCapacitySatisfied(ctx context.Context, conn *autoscaling.AutoScaling, elbconn *elb.ELB, elbv2conn *elbv2.ELBV2, name string, cb func(int, int) error, timeout time.Duration) error {
+func waitGroupCapacitySatisfied(ctx context.Context, conn *autoscaling.AutoScaling, elbconn *elb.ELB, elbv2conn *elbv2.ELBV2, name string, cb func(int, int) error, startTime time.Time, ignoreFailedScalingActivities bool, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Target:  []string{"ok"},
-		Refresh: statusGroupCapacity(ctx, conn, elbconn, elbv2conn, name, cb),
+		Refresh: statusGroupCapacity(ctx, conn, elbconn, elbv2conn, name, cb, startTime, ignoreFailedScalingActivities),
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	_, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(struct{ err error }); ok {
-		tfresource.SetLastError(err, output.err)
+	// On timeout, report any scaling activities that failed while waiting.
+	if tfresource.TimedOut(err) {
+		if scalingActivities, findErr := findScalingActivitiesByName(ctx, conn, name, startTime); findErr == nil {
+			tfresource.SetLastError(err, failedScalingActivitiesError(scalingActivities))
+		}
 	}
 
 	return err
@@ -2504,6 +2551,30 @@ func waitInstanceRefreshCancelled(ctx context.Context, conn *autoscaling.AutoSca
 	return nil, err
 }
 
+func waitInstanceRefreshSuccessful(ctx context.Context, conn *autoscaling.AutoScaling, name, id string, timeout time.Duration) (*autoscaling.InstanceRefresh, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			autoscaling.InstanceRefreshStatusCancelling,
+			autoscaling.InstanceRefreshStatusInProgress,
+			autoscaling.InstanceRefreshStatusPending,
+			autoscaling.InstanceRefreshStatusRollbackInProgress,
+		},
+		Target:  []string{autoscaling.InstanceRefreshStatusSuccessful},
+		Refresh: statusInstanceRefresh(ctx, conn, name, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitWarmPoolDeleted(ctx context.Context, conn *autoscaling.AutoScaling, name string, timeout time.Duration) (*autoscaling.WarmPoolConfiguration, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{autoscaling.WarmPoolStatusPendingDelete},
@@ -3682,10 +3753,10 @@ func cancelInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, n
 	return nil
 }
 
-func startInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, input *autoscaling.StartInstanceRefreshInput) error {
+func startInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, input *autoscaling.StartInstanceRefreshInput) (string, error) {
 	name := aws.StringValue(input.AutoScalingGroupName)
 
-	_, err := tfresource.RetryWhen(ctx, instanceRefreshStartedTimeout,
+	outputRaw, err := tfresource.RetryWhen(ctx, instanceRefreshStartedTimeout,
 		func() (interface{}, error) {
 			return conn.StartInstanceRefreshWithContext(ctx, input)
 		},
@@ -3702,10 +3773,10 @@ func startInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, in
 		})
 
 	if err != nil {
-		return fmt.Errorf("starting Auto Scaling Group (%s) instance refresh: %w", name, err)
+		return "", fmt.Errorf("starting Auto Scaling Group (%s) instance refresh: %w", name, err)
 	}
 
-	return nil
+	return aws.StringValue(outputRaw.(*autoscaling.StartInstanceRefreshOutput).InstanceRefreshId), nil
 }
 
 func validateGroupInstanceRefreshTriggerFields(i interface{}, path cty.Path) diag.Diagnostics {
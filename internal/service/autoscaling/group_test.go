@@ -37,6 +37,7 @@ func testAccGroupImportStep(n string) resource.TestStep {
 		ImportStateVerify: true,
 		ImportStateVerifyIgnore: []string{
 			"force_delete",
+			"ignore_failed_scaling_activities",
 			"initial_lifecycle_hook",
 			"tag",
 			"tags",
@@ -76,6 +77,7 @@ func TestAccAutoScalingGroup_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "force_delete_warm_pool", "false"),
 					resource.TestCheckResourceAttr(resourceName, "health_check_grace_period", "300"),
 					resource.TestCheckResourceAttr(resourceName, "health_check_type", "EC2"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_failed_scaling_activities", "false"),
 					resource.TestCheckResourceAttr(resourceName, "initial_lifecycle_hook.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "instance_refresh.#", "0"),
 					resource.TestCheckResourceAttrPair(resourceName, "launch_configuration", "aws_launch_configuration.test", "name"),
@@ -1134,6 +1136,38 @@ func TestAccAutoScalingGroup_InstanceRefresh_start(t *testing.T) {
 	})
 }
 
+func TestAccAutoScalingGroup_InstanceRefresh_waitForInstanceRefresh(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group autoscaling.Group
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_autoscaling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_instanceRefreshWait(rName, acctest.ResourcePrefix+"-1-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.wait_for_instance_refresh", "true"),
+					testAccCheckInstanceRefreshCount(ctx, &group, 0),
+				),
+			},
+			{
+				Config: testAccGroupConfig_instanceRefreshWait(rName, acctest.ResourcePrefix+"-2-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &group),
+					testAccCheckInstanceRefreshCount(ctx, &group, 1),
+					testAccCheckInstanceRefreshStatus(ctx, &group, 0, autoscaling.InstanceRefreshStatusSuccessful),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAutoScalingGroup_InstanceRefresh_triggers(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group autoscaling.Group
@@ -4507,6 +4541,52 @@ resource "aws_launch_configuration" "test" {
 `, rName, launchConfigurationNamePrefix))
 }
 
+func testAccGroupConfig_instanceRefreshWait(rName, launchConfigurationNamePrefix string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAvailableAZsNoOptInDefaultExclude(),
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones   = [data.aws_availability_zones.available.names[0]]
+  name                 = %[1]q
+  max_size             = 2
+  min_size             = 1
+  desired_capacity     = 1
+  launch_configuration = aws_launch_configuration.test.name
+
+  instance_refresh {
+    strategy                  = "Rolling"
+    wait_for_instance_refresh = true
+
+    preferences {
+      instance_warmup        = 0
+      min_healthy_percentage = 0
+    }
+  }
+
+  timeouts {
+    update = "30m"
+  }
+
+  tag {
+    key                 = "Name"
+    value               = %[1]q
+    propagate_at_launch = true
+  }
+}
+
+resource "aws_launch_configuration" "test" {
+  name_prefix   = %[2]q
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = "t3.nano"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, rName, launchConfigurationNamePrefix))
+}
+
 func testAccGroupConfig_instanceRefreshTriggers(rName string) string {
 	return acctest.ConfigCompose(testAccGroupConfig_launchConfigurationBase(rName, "t3.nano"), fmt.Sprintf(`
 resource "aws_autoscaling_group" "test" {
@@ -3,9 +3,14 @@ package cloudfront
 const (
 	StreamTypeKinesis = "Kinesis"
 
-	ResNameDistribution         = "Distribution"
-	ResNamePublicKey            = "Public Key"
-	ResNameOriginAccessIdentity = "Origin Access Identity"
+	DistributionStatusDeployed   = "Deployed"
+	DistributionStatusInProgress = "InProgress"
+
+	ResNameContinuousDeploymentPolicy = "Continuous Deployment Policy"
+	ResNameDistribution               = "Distribution"
+	ResNameDistributionPromotion      = "Distribution Promotion"
+	ResNamePublicKey                  = "Public Key"
+	ResNameOriginAccessIdentity       = "Origin Access Identity"
 )
 
 func StreamType_Values() []string {
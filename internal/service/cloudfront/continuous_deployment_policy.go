@@ -0,0 +1,494 @@
+package cloudfront
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudfront_continuous_deployment_policy")
+func ResourceContinuousDeploymentPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContinuousDeploymentPolicyCreate,
+		ReadWithoutTimeout:   resourceContinuousDeploymentPolicyRead,
+		UpdateWithoutTimeout: resourceContinuousDeploymentPolicyUpdate,
+		DeleteWithoutTimeout: resourceContinuousDeploymentPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"primary_distribution_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"staging_distribution_dns_names": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"items": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"quantity": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"traffic_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"single_header_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"header": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"single_weight_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"session_stickiness_config": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"idle_ttl": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(300, 3600),
+												},
+												"maximum_ttl": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(300, 3600),
+												},
+											},
+										},
+									},
+									"weight": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										ValidateFunc: validation.FloatBetween(0, 0.15),
+									},
+								},
+							},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cloudfront.ContinuousDeploymentPolicyType_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceContinuousDeploymentPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
+
+	input := &cloudfront.CreateContinuousDeploymentPolicyInput{
+		ContinuousDeploymentPolicyConfig: expandContinuousDeploymentPolicyConfig(d),
+	}
+
+	output, err := conn.CreateContinuousDeploymentPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionCreating, ResNameContinuousDeploymentPolicy, "", err)
+	}
+
+	d.SetId(aws.StringValue(output.ContinuousDeploymentPolicy.Id))
+
+	return resourceContinuousDeploymentPolicyRead(ctx, d, meta)
+}
+
+func resourceContinuousDeploymentPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
+
+	output, err := FindContinuousDeploymentPolicyByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.CloudFront, create.ErrActionReading, ResNameContinuousDeploymentPolicy, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionReading, ResNameContinuousDeploymentPolicy, d.Id(), err)
+	}
+
+	config := output.ContinuousDeploymentPolicy.ContinuousDeploymentPolicyConfig
+
+	d.Set("enabled", config.Enabled)
+	d.Set("etag", output.ETag)
+	d.Set("last_modified_time", aws.TimeValue(output.ContinuousDeploymentPolicy.LastModifiedTime).Format(time.RFC3339))
+	if err := d.Set("staging_distribution_dns_names", flattenStagingDistributionDNSNames(config.StagingDistributionDnsNames)); err != nil {
+		return create.DiagSettingError(names.CloudFront, ResNameContinuousDeploymentPolicy, d.Id(), "staging_distribution_dns_names", err)
+	}
+	if err := d.Set("traffic_config", flattenTrafficConfig(config.TrafficConfig)); err != nil {
+		return create.DiagSettingError(names.CloudFront, ResNameContinuousDeploymentPolicy, d.Id(), "traffic_config", err)
+	}
+
+	return nil
+}
+
+func resourceContinuousDeploymentPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
+
+	input := &cloudfront.UpdateContinuousDeploymentPolicyInput{
+		ContinuousDeploymentPolicyConfig: expandContinuousDeploymentPolicyConfig(d),
+		Id:                               aws.String(d.Id()),
+		IfMatch:                          aws.String(d.Get("etag").(string)),
+	}
+
+	_, err := conn.UpdateContinuousDeploymentPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionUpdating, ResNameContinuousDeploymentPolicy, d.Id(), err)
+	}
+
+	return resourceContinuousDeploymentPolicyRead(ctx, d, meta)
+}
+
+func resourceContinuousDeploymentPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
+
+	log.Printf("[INFO] Deleting CloudFront Continuous Deployment Policy: %s", d.Id())
+	err := deleteContinuousDeploymentPolicy(ctx, conn, d.Id(), d.Get("etag").(string))
+
+	// The policy is still attached to the primary distribution. Detach it and try again.
+	if v, ok := d.GetOk("primary_distribution_id"); ok && tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeContinuousDeploymentPolicyInUse) {
+		if err := detachContinuousDeploymentPolicy(ctx, conn, d.Id(), v.(string), meta); err != nil {
+			return create.DiagError(names.CloudFront, create.ErrActionDeleting, ResNameContinuousDeploymentPolicy, d.Id(), err)
+		}
+
+		var output *cloudfront.GetContinuousDeploymentPolicyOutput
+		output, err = FindContinuousDeploymentPolicyByID(ctx, conn, d.Id())
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return create.DiagError(names.CloudFront, create.ErrActionDeleting, ResNameContinuousDeploymentPolicy, d.Id(), err)
+		}
+
+		err = deleteContinuousDeploymentPolicy(ctx, conn, d.Id(), aws.StringValue(output.ETag))
+	}
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchContinuousDeploymentPolicy) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionDeleting, ResNameContinuousDeploymentPolicy, d.Id(), err)
+	}
+
+	return nil
+}
+
+func deleteContinuousDeploymentPolicy(ctx context.Context, conn *cloudfront.CloudFront, id, etag string) error {
+	_, err := conn.DeleteContinuousDeploymentPolicyWithContext(ctx, &cloudfront.DeleteContinuousDeploymentPolicyInput{
+		Id:      aws.String(id),
+		IfMatch: aws.String(etag),
+	})
+
+	return err
+}
+
+// detachContinuousDeploymentPolicy removes the continuous deployment policy from the specified primary distribution if it references the policy.
+func detachContinuousDeploymentPolicy(ctx context.Context, conn *cloudfront.CloudFront, id, distributionID string, meta interface{}) error {
+	output, err := FindDistributionByID(ctx, conn, distributionID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	config := output.Distribution.DistributionConfig
+
+	if aws.StringValue(config.ContinuousDeploymentPolicyId) != id {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Detaching CloudFront Continuous Deployment Policy (%s) from Distribution (%s)", id, distributionID)
+	config.ContinuousDeploymentPolicyId = aws.String("")
+
+	_, err = conn.UpdateDistributionWithContext(ctx, &cloudfront.UpdateDistributionInput{
+		DistributionConfig: config,
+		Id:                 aws.String(distributionID),
+		IfMatch:            output.ETag,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return DistributionWaitUntilDeployed(ctx, distributionID, meta)
+}
+
+func FindContinuousDeploymentPolicyByID(ctx context.Context, conn *cloudfront.CloudFront, id string) (*cloudfront.GetContinuousDeploymentPolicyOutput, error) {
+	input := &cloudfront.GetContinuousDeploymentPolicyInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetContinuousDeploymentPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchContinuousDeploymentPolicy) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContinuousDeploymentPolicy == nil || output.ContinuousDeploymentPolicy.ContinuousDeploymentPolicyConfig == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandContinuousDeploymentPolicyConfig(d *schema.ResourceData) *cloudfront.ContinuousDeploymentPolicyConfig {
+	apiObject := &cloudfront.ContinuousDeploymentPolicyConfig{
+		Enabled: aws.Bool(d.Get("enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("staging_distribution_dns_names"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.StagingDistributionDnsNames = expandStagingDistributionDNSNames(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("traffic_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.TrafficConfig = expandTrafficConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandStagingDistributionDNSNames(tfMap map[string]interface{}) *cloudfront.StagingDistributionDnsNames {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudfront.StagingDistributionDnsNames{}
+
+	if v, ok := tfMap["items"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Items = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["quantity"].(int); ok {
+		apiObject.Quantity = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandTrafficConfig(tfMap map[string]interface{}) *cloudfront.TrafficConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudfront.TrafficConfig{}
+
+	if v, ok := tfMap["single_header_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SingleHeaderConfig = expandContinuousDeploymentSingleHeaderConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["single_weight_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SingleWeightConfig = expandContinuousDeploymentSingleWeightConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandContinuousDeploymentSingleHeaderConfig(tfMap map[string]interface{}) *cloudfront.ContinuousDeploymentSingleHeaderConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudfront.ContinuousDeploymentSingleHeaderConfig{}
+
+	if v, ok := tfMap["header"].(string); ok && v != "" {
+		apiObject.Header = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandContinuousDeploymentSingleWeightConfig(tfMap map[string]interface{}) *cloudfront.ContinuousDeploymentSingleWeightConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudfront.ContinuousDeploymentSingleWeightConfig{}
+
+	if v, ok := tfMap["session_stickiness_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SessionStickinessConfig = expandSessionStickinessConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["weight"].(float64); ok {
+		apiObject.Weight = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandSessionStickinessConfig(tfMap map[string]interface{}) *cloudfront.SessionStickinessConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudfront.SessionStickinessConfig{}
+
+	if v, ok := tfMap["idle_ttl"].(int); ok {
+		apiObject.IdleTTL = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_ttl"].(int); ok {
+		apiObject.MaximumTTL = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenStagingDistributionDNSNames(apiObject *cloudfront.StagingDistributionDnsNames) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"quantity": aws.Int64Value(apiObject.Quantity),
+	}
+
+	if v := apiObject.Items; len(v) > 0 {
+		tfMap["items"] = aws.StringValueSlice(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTrafficConfig(apiObject *cloudfront.TrafficConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"type": aws.StringValue(apiObject.Type),
+	}
+
+	if v := flattenContinuousDeploymentSingleHeaderConfig(apiObject.SingleHeaderConfig); len(v) > 0 {
+		tfMap["single_header_config"] = v
+	}
+
+	if v := flattenContinuousDeploymentSingleWeightConfig(apiObject.SingleWeightConfig); len(v) > 0 {
+		tfMap["single_weight_config"] = v
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenContinuousDeploymentSingleHeaderConfig(apiObject *cloudfront.ContinuousDeploymentSingleHeaderConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"header": aws.StringValue(apiObject.Header),
+		"value":  aws.StringValue(apiObject.Value),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenContinuousDeploymentSingleWeightConfig(apiObject *cloudfront.ContinuousDeploymentSingleWeightConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"weight": aws.Float64Value(apiObject.Weight),
+	}
+
+	if v := flattenSessionStickinessConfig(apiObject.SessionStickinessConfig); len(v) > 0 {
+		tfMap["session_stickiness_config"] = v
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSessionStickinessConfig(apiObject *cloudfront.SessionStickinessConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"idle_ttl":    aws.Int64Value(apiObject.IdleTTL),
+		"maximum_ttl": aws.Int64Value(apiObject.MaximumTTL),
+	}
+
+	return []interface{}{tfMap}
+}
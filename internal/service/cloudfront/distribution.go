@@ -34,12 +34,17 @@ func ResourceDistribution() *schema.Resource {
 				// Set non API attributes to their Default settings in the schema
 				d.Set("retain_on_delete", false)
 				d.Set("wait_for_deployment", true)
+				d.Set("wait_for_deployment_on_delete", true)
 				return []*schema.ResourceData{d}, nil
 			},
 		},
 		MigrateState:  resourceDistributionMigrateState,
 		SchemaVersion: 1,
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -230,6 +235,10 @@ func ResourceDistribution() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 128),
 			},
+			"continuous_deployment_policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"custom_error_response": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -715,6 +724,12 @@ func ResourceDistribution() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"staging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -813,6 +828,11 @@ func ResourceDistribution() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"wait_for_deployment_on_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"is_ipv6_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -940,65 +960,69 @@ func resourceDistributionRead(ctx context.Context, d *schema.ResourceData, meta
 func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFrontConn()
-	params := &cloudfront.UpdateDistributionInput{
-		Id:                 aws.String(d.Id()),
-		DistributionConfig: expandDistributionConfig(d),
-		IfMatch:            aws.String(d.Get("etag").(string)),
-	}
 
-	// Handle eventual consistency issues
-	err := resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
-		_, err := conn.UpdateDistributionWithContext(ctx, params)
-
-		// ACM and IAM certificate eventual consistency
-		// InvalidViewerCertificate: The specified SSL certificate doesn't exist, isn't in us-east-1 region, isn't valid, or doesn't include a valid certificate chain.
-		if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeInvalidViewerCertificate) {
-			return resource.RetryableError(err)
+	// Changes to non-API attributes only, e.g. setting retain_on_delete after import, don't require a distribution update.
+	if d.HasChangesExcept("retain_on_delete", "tags", "tags_all", "wait_for_deployment", "wait_for_deployment_on_delete") {
+		params := &cloudfront.UpdateDistributionInput{
+			Id:                 aws.String(d.Id()),
+			DistributionConfig: expandDistributionConfig(d),
+			IfMatch:            aws.String(d.Get("etag").(string)),
 		}
 
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
+		// Handle eventual consistency issues
+		err := resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
+			_, err := conn.UpdateDistributionWithContext(ctx, params)
 
-		return nil
-	})
+			// ACM and IAM certificate eventual consistency
+			// InvalidViewerCertificate: The specified SSL certificate doesn't exist, isn't in us-east-1 region, isn't valid, or doesn't include a valid certificate chain.
+			if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeInvalidViewerCertificate) {
+				return resource.RetryableError(err)
+			}
 
-	// Refresh our ETag if it is out of date and attempt update again
-	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodePreconditionFailed) {
-		getDistributionInput := &cloudfront.GetDistributionInput{
-			Id: aws.String(d.Id()),
-		}
-		var getDistributionOutput *cloudfront.GetDistributionOutput
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-		log.Printf("[DEBUG] Refreshing CloudFront Distribution (%s) ETag", d.Id())
-		getDistributionOutput, err = conn.GetDistributionWithContext(ctx, getDistributionInput)
+			return nil
+		})
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "refreshing CloudFront Distribution (%s) ETag: %s", d.Id(), err)
-		}
+		// Refresh our ETag if it is out of date and attempt update again
+		if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodePreconditionFailed) {
+			getDistributionInput := &cloudfront.GetDistributionInput{
+				Id: aws.String(d.Id()),
+			}
+			var getDistributionOutput *cloudfront.GetDistributionOutput
 
-		if getDistributionOutput == nil {
-			return sdkdiag.AppendErrorf(diags, "refreshing CloudFront Distribution (%s) ETag: empty response", d.Id())
-		}
+			log.Printf("[DEBUG] Refreshing CloudFront Distribution (%s) ETag", d.Id())
+			getDistributionOutput, err = conn.GetDistributionWithContext(ctx, getDistributionInput)
 
-		params.IfMatch = getDistributionOutput.ETag
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "refreshing CloudFront Distribution (%s) ETag: %s", d.Id(), err)
+			}
 
-		_, err = conn.UpdateDistributionWithContext(ctx, params)
-	}
+			if getDistributionOutput == nil {
+				return sdkdiag.AppendErrorf(diags, "refreshing CloudFront Distribution (%s) ETag: empty response", d.Id())
+			}
 
-	// Propagate AWS Go SDK retried error, if any
-	if tfresource.TimedOut(err) {
-		_, err = conn.UpdateDistributionWithContext(ctx, params)
-	}
+			params.IfMatch = getDistributionOutput.ETag
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating CloudFront Distribution (%s): %s", d.Id(), err)
-	}
+			_, err = conn.UpdateDistributionWithContext(ctx, params)
+		}
 
-	if d.Get("wait_for_deployment").(bool) {
-		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
-		if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+		// Propagate AWS Go SDK retried error, if any
+		if tfresource.TimedOut(err) {
+			_, err = conn.UpdateDistributionWithContext(ctx, params)
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudFront Distribution (%s): %s", d.Id(), err)
+		}
+
+		if d.Get("wait_for_deployment").(bool) {
+			log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
+			if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+			}
 		}
 	}
 
@@ -1016,114 +1040,101 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
-	if d.Get("retain_on_delete").(bool) {
-		// Check if we need to disable first.
-		output, err := FindDistributionByID(ctx, conn, d.Id())
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "reading CloudFront Distribution (%s): %s", d.Id(), err)
-		}
-
-		if !aws.BoolValue(output.Distribution.DistributionConfig.Enabled) {
-			log.Printf("[WARN] Removing CloudFront Distribution ID %q with `retain_on_delete` set. Please delete this distribution manually.", d.Id())
-			return diags
-		}
-
-		input := &cloudfront.UpdateDistributionInput{
-			DistributionConfig: output.Distribution.DistributionConfig,
-			Id:                 aws.String(d.Id()),
-			IfMatch:            output.ETag,
-		}
-		input.DistributionConfig.Enabled = aws.Bool(false)
+	// Disable the distribution and wait for the change to be deployed, which is required before deletion.
+	// Here we update via the deployed configuration to ensure we are not submitting an out of date
+	// configuration from the Terraform configuration, should other changes have occurred manually.
+	// With wait_for_deployment_on_delete disabled the deletion below is retried, for up to the delete timeout, until the distribution is disabled.
+	skipWait := d.Get("retain_on_delete").(bool) || !d.Get("wait_for_deployment_on_delete").(bool)
+	err := disableDistribution(ctx, conn, d.Id(), skipWait, meta)
 
-		_, err = conn.UpdateDistributionWithContext(ctx, input)
+	if tfresource.NotFound(err) {
+		return diags
+	}
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "disabling CloudFront Distribution (%s): %s", d.Id(), err)
-		}
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling CloudFront Distribution (%s): %s", d.Id(), err)
+	}
 
+	if d.Get("retain_on_delete").(bool) {
 		log.Printf("[WARN] Removing CloudFront Distribution ID %q with `retain_on_delete` set. Please delete this distribution manually.", d.Id())
 		return diags
 	}
 
-	deleteDistroInput := &cloudfront.DeleteDistributionInput{
-		Id:      aws.String(d.Id()),
-		IfMatch: aws.String(d.Get("etag").(string)),
-	}
-
 	log.Printf("[DEBUG] Deleting CloudFront Distribution: %s", d.Id())
-	_, err := conn.DeleteDistributionWithContext(ctx, deleteDistroInput)
-
-	if err == nil || tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchDistribution) {
+	// CloudFront has eventual consistency issues even for "deployed" state.
+	// Occasionally the DeleteDistribution call will return these errors, in which retries with a refreshed ETag will succeed:
+	//   * DistributionNotDisabled: The distribution you are trying to delete has not been disabled
+	//   * PreconditionFailed: The request failed because it didn't meet the preconditions in one or more request-header fields
+	//   * InvalidIfMatchVersion: The If-Match version is missing or not valid
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return nil, deleteDistribution(ctx, conn, d.Id())
+	}, cloudfront.ErrCodeDistributionNotDisabled, cloudfront.ErrCodePreconditionFailed, cloudfront.ErrCodeInvalidIfMatchVersion)
+
+	if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchDistribution) {
 		return diags
 	}
 
-	// Refresh our ETag if it is out of date and attempt deletion again.
-	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeInvalidIfMatchVersion) {
-		var output *cloudfront.GetDistributionOutput
-		output, err = FindDistributionByID(ctx, conn, d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudFront Distribution (%s): %s", d.Id(), err)
+	}
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "reading CloudFront Distribution (%s): %s", d.Id(), err)
-		}
+	return diags
+}
 
-		deleteDistroInput.IfMatch = output.ETag
+// disableDistribution disables the distribution, if it is not already disabled, and optionally waits for the change to be deployed.
+func disableDistribution(ctx context.Context, conn *cloudfront.CloudFront, id string, skipWait bool, meta interface{}) error {
+	output, err := FindDistributionByID(ctx, conn, id)
 
-		_, err = conn.DeleteDistributionWithContext(ctx, deleteDistroInput)
+	if err != nil {
+		return err
 	}
 
-	// Disable distribution if it is not yet disabled and attempt deletion again.
-	// Here we update via the deployed configuration to ensure we are not submitting an out of date
-	// configuration from the Terraform configuration, should other changes have occurred manually.
-	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeDistributionNotDisabled) {
-		var output *cloudfront.GetDistributionOutput
-		output, err = FindDistributionByID(ctx, conn, d.Id())
+	if aws.BoolValue(output.Distribution.DistributionConfig.Enabled) {
+		_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, distributionDeleteRetryTimeout, func() (interface{}, error) {
+			output, err := FindDistributionByID(ctx, conn, id)
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "reading CloudFront Distribution (%s): %s", d.Id(), err)
-		}
+			if err != nil {
+				return nil, err
+			}
 
-		updateDistroInput := &cloudfront.UpdateDistributionInput{
-			DistributionConfig: output.Distribution.DistributionConfig,
-			Id:                 aws.String(d.Id()),
-			IfMatch:            output.ETag,
-		}
-		updateDistroInput.DistributionConfig.Enabled = aws.Bool(false)
-		var updateDistroOutput *cloudfront.UpdateDistributionOutput
+			input := &cloudfront.UpdateDistributionInput{
+				DistributionConfig: output.Distribution.DistributionConfig,
+				Id:                 aws.String(id),
+				IfMatch:            output.ETag,
+			}
+			input.DistributionConfig.Enabled = aws.Bool(false)
 
-		updateDistroOutput, err = conn.UpdateDistributionWithContext(ctx, updateDistroInput)
+			return conn.UpdateDistributionWithContext(ctx, input)
+		}, cloudfront.ErrCodePreconditionFailed, cloudfront.ErrCodeInvalidIfMatchVersion)
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "disabling CloudFront Distribution (%s): %s", d.Id(), err)
-		}
-
-		if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
-		}
-
-		deleteDistroInput.IfMatch = updateDistroOutput.ETag
-
-		_, err = conn.DeleteDistributionWithContext(ctx, deleteDistroInput)
-
-		// CloudFront has eventual consistency issues even for "deployed" state.
-		// Occasionally the DeleteDistribution call will return this error as well, in which retries will succeed:
-		//   * PreconditionFailed: The request failed because it didn't meet the preconditions in one or more request-header fields
-		if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeDistributionNotDisabled, cloudfront.ErrCodePreconditionFailed) {
-			_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
-				return conn.DeleteDistributionWithContext(ctx, deleteDistroInput)
-			}, cloudfront.ErrCodeDistributionNotDisabled, cloudfront.ErrCodePreconditionFailed)
+			return err
 		}
+	} else if aws.StringValue(output.Distribution.Status) == DistributionStatusDeployed {
+		return nil
 	}
 
-	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchDistribution) {
-		return diags
+	if skipWait {
+		return nil
 	}
 
+	return DistributionWaitUntilDeployed(ctx, id, meta)
+}
+
+// deleteDistribution deletes the distribution using its current ETag.
+func deleteDistribution(ctx context.Context, conn *cloudfront.CloudFront, id string) error {
+	output, err := FindDistributionByID(ctx, conn, id)
+
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting CloudFront Distribution (%s): %s", d.Id(), err)
+		return err
 	}
 
-	return diags
+	_, err = conn.DeleteDistributionWithContext(ctx, &cloudfront.DeleteDistributionInput{
+		Id:      aws.String(id),
+		IfMatch: output.ETag,
+	})
+
+	return err
 }
 
 func FindDistributionByID(ctx context.Context, conn *cloudfront.CloudFront, id string) (*cloudfront.GetDistributionOutput, error) {
@@ -1151,13 +1162,17 @@ func FindDistributionByID(ctx context.Context, conn *cloudfront.CloudFront, id s
 	return output, nil
 }
 
+const (
+	distributionDeleteRetryTimeout = 5 * time.Minute
+)
+
 // resourceAwsCloudFrontWebDistributionWaitUntilDeployed blocks until the
 // distribution is deployed. It currently takes exactly 15 minutes to deploy
 // but that might change in the future.
 func DistributionWaitUntilDeployed(ctx context.Context, id string, meta interface{}) error {
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"InProgress"},
-		Target:     []string{"Deployed"},
+		Pending:    []string{DistributionStatusInProgress},
+		Target:     []string{DistributionStatusDeployed},
 		Refresh:    resourceWebDistributionStateRefreshFunc(ctx, id, meta),
 		Timeout:    90 * time.Minute,
 		MinTimeout: 15 * time.Second,
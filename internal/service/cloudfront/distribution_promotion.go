@@ -0,0 +1,110 @@
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudfront_distribution_promotion")
+func ResourceDistributionPromotion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDistributionPromotionCreate,
+		ReadWithoutTimeout:   resourceDistributionPromotionRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Schema: map[string]*schema.Schema{
+			"distribution_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"staging_distribution_etag": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"staging_distribution_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"wait_for_deployment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceDistributionPromotionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
+
+	id := d.Get("distribution_id").(string)
+	primary, err := FindDistributionByID(ctx, conn, id)
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionCreating, ResNameDistributionPromotion, id, err)
+	}
+
+	// The staging distribution's ETag is taken from configuration so that only the planned staging configuration is promoted.
+	input := &cloudfront.UpdateDistributionWithStagingConfigInput{
+		Id:                    aws.String(id),
+		IfMatch:               aws.String(fmt.Sprintf("%s, %s", aws.StringValue(primary.ETag), d.Get("staging_distribution_etag").(string))),
+		StagingDistributionId: aws.String(d.Get("staging_distribution_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Promoting CloudFront Distribution: %s", input)
+	_, err = conn.UpdateDistributionWithStagingConfigWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionCreating, ResNameDistributionPromotion, id, err)
+	}
+
+	d.SetId(id)
+
+	if d.Get("wait_for_deployment").(bool) {
+		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
+		if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
+			return create.DiagError(names.CloudFront, create.ErrActionWaitingForCreation, ResNameDistributionPromotion, d.Id(), err)
+		}
+	}
+
+	return resourceDistributionPromotionRead(ctx, d, meta)
+}
+
+func resourceDistributionPromotionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
+
+	output, err := FindDistributionByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.CloudFront, create.ErrActionReading, ResNameDistributionPromotion, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionReading, ResNameDistributionPromotion, d.Id(), err)
+	}
+
+	d.Set("distribution_id", output.Distribution.Id)
+	d.Set("etag", output.ETag)
+
+	return nil
+}
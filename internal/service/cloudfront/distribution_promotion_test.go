@@ -0,0 +1,53 @@
+package cloudfront_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudFrontDistributionPromotion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var distribution cloudfront.Distribution
+	resourceName := "aws_cloudfront_distribution_promotion.test"
+	primaryDistributionResourceName := "aws_cloudfront_distribution.test"
+	stagingDistributionResourceName := "aws_cloudfront_distribution.staging"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContinuousDeploymentPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_basic(true, false),
+			},
+			{
+				Config: testAccDistributionPromotionConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, primaryDistributionResourceName, &distribution),
+					resource.TestCheckResourceAttrPair(resourceName, "distribution_id", primaryDistributionResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "staging_distribution_id", stagingDistributionResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "staging_distribution_etag", stagingDistributionResourceName, "etag"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDistributionPromotionConfig_basic() string {
+	return acctest.ConfigCompose(testAccContinuousDeploymentPolicyConfig_basic(true, true), `
+resource "aws_cloudfront_distribution_promotion" "test" {
+  distribution_id           = aws_cloudfront_distribution.test.id
+  staging_distribution_id   = aws_cloudfront_distribution.staging.id
+  staging_distribution_etag = aws_cloudfront_distribution.staging.etag
+}
+`)
+}
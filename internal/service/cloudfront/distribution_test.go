@@ -1093,6 +1093,58 @@ func TestAccCloudFrontDistribution_enabled(t *testing.T) {
 	})
 }
 
+// TestAccCloudFrontDistribution_retainOnDeleteImport verifies that retain_on_delete
+// can be set on an imported distribution without updating the distribution itself.
+func TestAccCloudFrontDistribution_retainOnDeleteImport(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var distribution cloudfront.Distribution
+	resourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionConfig_enabled(true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					resource.TestCheckResourceAttr(resourceName, "retain_on_delete", "false"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_deployment_on_delete", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDistributionConfig_enabled(true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					resource.TestCheckResourceAttr(resourceName, "retain_on_delete", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Deployed"),
+				),
+			},
+			{
+				Config:  testAccDistributionConfig_enabled(true, true),
+				Destroy: true,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExistsAPIOnly(ctx, &distribution),
+					testAccCheckDistributionWaitForDeployment(ctx, &distribution),
+					testAccCheckDistributionDisabled(&distribution),
+					testAccCheckDistributionDisappears(ctx, &distribution),
+				),
+			},
+		},
+	})
+}
+
 // TestAccCloudFrontDistribution_retainOnDelete verifies retain_on_delete = true
 // This acceptance test performs the following steps:
 //   - Trigger a Terraform destroy of the resource, which should only disable the distribution
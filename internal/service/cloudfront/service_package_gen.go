@@ -66,10 +66,18 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceCachePolicy,
 			TypeName: "aws_cloudfront_cache_policy",
 		},
+		{
+			Factory:  ResourceContinuousDeploymentPolicy,
+			TypeName: "aws_cloudfront_continuous_deployment_policy",
+		},
 		{
 			Factory:  ResourceDistribution,
 			TypeName: "aws_cloudfront_distribution",
 		},
+		{
+			Factory:  ResourceDistributionPromotion,
+			TypeName: "aws_cloudfront_distribution_promotion",
+		},
 		{
 			Factory:  ResourceFieldLevelEncryptionConfig,
 			TypeName: "aws_cloudfront_field_level_encryption_config",
@@ -36,6 +36,28 @@ func ResourceCompositeAlarm() *schema.Resource {
 				Default:  true,
 				ForceNew: true,
 			},
+			"actions_suppressor": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1600),
+						},
+						"extension_period": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"wait_period": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
 			"alarm_actions": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -172,6 +194,14 @@ func resourceCompositeAlarmRead(ctx context.Context, d *schema.ResourceData, met
 
 	d.Set("actions_enabled", alarm.ActionsEnabled)
 
+	if alarm.ActionsSuppressor != nil {
+		if err := d.Set("actions_suppressor", []interface{}{flattenActionsSuppressor(alarm)}); err != nil {
+			return diag.Errorf("error setting actions_suppressor: %s", err)
+		}
+	} else {
+		d.Set("actions_suppressor", nil)
+	}
+
 	if err := d.Set("alarm_actions", flex.FlattenStringSet(alarm.AlarmActions)); err != nil {
 		return diag.Errorf("error setting alarm_actions: %s", err)
 	}
@@ -273,6 +303,22 @@ func expandPutCompositeAlarmInput(ctx context.Context, d *schema.ResourceData, m
 		ActionsEnabled: aws.Bool(d.Get("actions_enabled").(bool)),
 	}
 
+	if v, ok := d.GetOk("actions_suppressor"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		if v, ok := tfMap["alarm"].(string); ok && v != "" {
+			out.ActionsSuppressor = aws.String(v)
+		}
+
+		if v, ok := tfMap["extension_period"].(int); ok {
+			out.ActionsSuppressorExtensionPeriod = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["wait_period"].(int); ok {
+			out.ActionsSuppressorWaitPeriod = aws.Int64(int64(v))
+		}
+	}
+
 	if v, ok := d.GetOk("alarm_actions"); ok {
 		out.AlarmActions = flex.ExpandStringSet(v.(*schema.Set))
 	}
@@ -303,3 +349,17 @@ func expandPutCompositeAlarmInput(ctx context.Context, d *schema.ResourceData, m
 
 	return out
 }
+
+func flattenActionsSuppressor(alarm *cloudwatch.CompositeAlarm) map[string]interface{} {
+	if alarm == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"alarm":            aws.StringValue(alarm.ActionsSuppressor),
+		"extension_period": aws.Int64Value(alarm.ActionsSuppressorExtensionPeriod),
+		"wait_period":      aws.Int64Value(alarm.ActionsSuppressorWaitPeriod),
+	}
+
+	return tfMap
+}
@@ -386,6 +386,53 @@ func TestAccCloudWatchCompositeAlarm_updateAlarmRule(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchCompositeAlarm_actionsSuppressor(t *testing.T) {
+	ctx := acctest.Context(t)
+	suffix := sdkacctest.RandString(8)
+	resourceName := "aws_cloudwatch_composite_alarm.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCompositeAlarmDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCompositeAlarmConfig_actionsSuppressor(suffix, 0, 60, 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCompositeAlarmExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "actions_suppressor.0.alarm", "aws_cloudwatch_metric_alarm.test.0", "alarm_name"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.0.extension_period", "60"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.0.wait_period", "120"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCompositeAlarmConfig_actionsSuppressor(suffix, 1, 30, 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCompositeAlarmExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "actions_suppressor.0.alarm", "aws_cloudwatch_metric_alarm.test.1", "alarm_name"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.0.extension_period", "30"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.0.wait_period", "90"),
+				),
+			},
+			{
+				Config: testAccCompositeAlarmConfig_basic(suffix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCompositeAlarmExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCompositeAlarmDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchConn()
@@ -627,3 +674,20 @@ resource "aws_cloudwatch_composite_alarm" "test" {
 }
 `, suffix))
 }
+
+func testAccCompositeAlarmConfig_actionsSuppressor(suffix string, index, extensionPeriod, waitPeriod int) string {
+	return acctest.ConfigCompose(
+		testAccCompositeAlarmBaseConfig(suffix),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_composite_alarm" "test" {
+  alarm_name = "tf-test-composite-%[1]s"
+  alarm_rule = join(" OR ", formatlist("ALARM(%%s)", aws_cloudwatch_metric_alarm.test[*].alarm_name))
+
+  actions_suppressor {
+    alarm            = aws_cloudwatch_metric_alarm.test[%[2]d].alarm_name
+    extension_period = %[3]d
+    wait_period      = %[4]d
+  }
+}
+`, suffix, index, extensionPeriod, waitPeriod))
+}
@@ -42,3 +42,27 @@ func FindMetricAlarmByName(ctx context.Context, conn *cloudwatch.CloudWatch, nam
 
 	return output.MetricAlarms[0], nil
 }
+
+func FindMetricAlarms(ctx context.Context, conn *cloudwatch.CloudWatch, input *cloudwatch.DescribeAlarmsInput) ([]*cloudwatch.MetricAlarm, error) {
+	var output []*cloudwatch.MetricAlarm
+
+	err := conn.DescribeAlarmsPagesWithContext(ctx, input, func(page *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MetricAlarms {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,133 @@
+package cloudwatch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_cloudwatch_metric_alarms")
+func DataSourceMetricAlarms() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMetricAlarmsRead,
+
+		Schema: map[string]*schema.Schema{
+			"alarm_name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"alarms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metric_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"metric_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"state_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(cloudwatch.StateValue_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceMetricAlarmsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchConn()
+
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmTypes: aws.StringSlice([]string{cloudwatch.AlarmTypeMetricAlarm}),
+	}
+
+	if v, ok := d.GetOk("alarm_name_prefix"); ok {
+		input.AlarmNamePrefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("state_value"); ok {
+		input.StateValue = aws.String(v.(string))
+	}
+
+	output, err := FindMetricAlarms(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Metric Alarms: %s", err)
+	}
+
+	// DescribeAlarms cannot filter by metric, so do it here.
+	metricName := d.Get("metric_name").(string)
+	namespace := d.Get("namespace").(string)
+
+	var alarms []interface{}
+	var arns []string
+
+	for _, v := range output {
+		if metricName != "" && aws.StringValue(v.MetricName) != metricName {
+			continue
+		}
+
+		if namespace != "" && aws.StringValue(v.Namespace) != namespace {
+			continue
+		}
+
+		alarms = append(alarms, map[string]interface{}{
+			"alarm_name":  aws.StringValue(v.AlarmName),
+			"arn":         aws.StringValue(v.AlarmArn),
+			"metric_name": aws.StringValue(v.MetricName),
+			"namespace":   aws.StringValue(v.Namespace),
+			"state_value": aws.StringValue(v.StateValue),
+		})
+		arns = append(arns, aws.StringValue(v.AlarmArn))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("alarms", alarms); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting alarms: %s", err)
+	}
+
+	d.Set("arns", arns)
+
+	return diags
+}
@@ -0,0 +1,82 @@
+package cloudwatch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudWatchMetricAlarmsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_metric_alarms.test"
+	metricDataSourceName := "data.aws_cloudwatch_metric_alarms.metric"
+	resourceName := "aws_cloudwatch_metric_alarm.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricAlarmsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "alarms.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "2"),
+					resource.TestCheckResourceAttr(metricDataSourceName, "alarms.#", "1"),
+					resource.TestCheckResourceAttrPair(metricDataSourceName, "alarms.0.alarm_name", resourceName, "alarm_name"),
+					resource.TestCheckResourceAttrPair(metricDataSourceName, "alarms.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(metricDataSourceName, "alarms.0.metric_name", "CPUUtilization"),
+					resource.TestCheckResourceAttr(metricDataSourceName, "alarms.0.namespace", "AWS/EC2"),
+					resource.TestCheckResourceAttrSet(metricDataSourceName, "alarms.0.state_value"),
+					resource.TestCheckResourceAttr(metricDataSourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(metricDataSourceName, "arns.0", resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetricAlarmsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = "%[1]s-cpu"
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 2
+  metric_name         = "CPUUtilization"
+  namespace           = "AWS/EC2"
+  period              = 120
+  statistic           = "Average"
+  threshold           = 80
+}
+
+resource "aws_cloudwatch_metric_alarm" "other" {
+  alarm_name          = "%[1]s-network"
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 2
+  metric_name         = "NetworkIn"
+  namespace           = "AWS/EC2"
+  period              = 120
+  statistic           = "Average"
+  threshold           = 80
+}
+
+data "aws_cloudwatch_metric_alarms" "test" {
+  alarm_name_prefix = %[1]q
+
+  depends_on = [aws_cloudwatch_metric_alarm.test, aws_cloudwatch_metric_alarm.other]
+}
+
+data "aws_cloudwatch_metric_alarms" "metric" {
+  alarm_name_prefix = %[1]q
+  metric_name       = "CPUUtilization"
+  namespace         = "AWS/EC2"
+
+  depends_on = [aws_cloudwatch_metric_alarm.test, aws_cloudwatch_metric_alarm.other]
+}
+`, rName)
+}
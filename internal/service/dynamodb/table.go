@@ -269,6 +269,7 @@ func ResourceTable() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"global_secondary_index": replicaGlobalSecondaryIndexSchema(),
 						names.AttrKMSKeyARN: {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -1135,6 +1136,10 @@ func createReplicas(ctx context.Context, conn *dynamodb.DynamoDB, tableName stri
 			replicaInput.KMSMasterKeyId = aws.String(v)
 		}
 
+		if v, ok := tfMap["global_secondary_index"].(*schema.Set); ok && v.Len() > 0 {
+			replicaInput.GlobalSecondaryIndexes = expandReplicaGlobalSecondaryIndexes(v.List())
+		}
+
 		input := &dynamodb.UpdateTableInput{
 			TableName: aws.String(tableName),
 			ReplicaUpdates: []*dynamodb.ReplicationGroupUpdate{
@@ -1144,9 +1149,10 @@ func createReplicas(ctx context.Context, conn *dynamodb.DynamoDB, tableName stri
 			},
 		}
 
-		// currently this would not be needed because (replica has these arguments):
+		// an update is needed when (replica has these arguments):
 		//   region_name can't be updated - new replica
 		//   kms_key_arn can't be updated - remove/add replica
+		//   global_secondary_index - read capacity overrides updated here
 		//   propagate_tags - handled elsewhere
 		//   point_in_time_recovery - handled elsewhere
 		// if provisioned_throughput_override or table_class_override were added, they could be updated here
@@ -1160,6 +1166,10 @@ func createReplicas(ctx context.Context, conn *dynamodb.DynamoDB, tableName stri
 				replicaInput.KMSMasterKeyId = aws.String(v)
 			}
 
+			if v, ok := tfMap["global_secondary_index"].(*schema.Set); ok && v.Len() > 0 {
+				replicaInput.GlobalSecondaryIndexes = expandReplicaGlobalSecondaryIndexes(v.List())
+			}
+
 			input = &dynamodb.UpdateTableInput{
 				TableName: aws.String(tableName),
 				ReplicaUpdates: []*dynamodb.ReplicationGroupUpdate{
@@ -1398,6 +1408,20 @@ func updateReplica(ctx context.Context, d *schema.ResourceData, conn *dynamodb.D
 				break
 			}
 
+			// update GSI read capacity overrides (and PITR along with them)
+			if o, n := mr["global_secondary_index"].(*schema.Set), ma["global_secondary_index"].(*schema.Set); !o.Equal(n) {
+				update := make(map[string]interface{}, len(ma))
+				for k, v := range ma {
+					update[k] = v
+				}
+				update["global_secondary_index"] = replicaGlobalSecondaryIndexesForUpdate(o, n)
+
+				if err := createReplicas(ctx, conn, d.Id(), []interface{}{update}, tfVersion, false, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("updating replica (%s) global secondary indexes: %w", ma["region_name"].(string), err)
+				}
+				break
+			}
+
 			// just update PITR
 			if ma["point_in_time_recovery"].(bool) != mr["point_in_time_recovery"].(bool) {
 				if err := updatePITR(ctx, conn, d.Id(), ma["point_in_time_recovery"].(bool), ma["region_name"].(string), tfVersion, d.Timeout(schema.TimeoutUpdate)); err != nil {
@@ -1737,10 +1761,8 @@ func enrichReplicas(ctx context.Context, conn *dynamodb.DynamoDB, arn, tableName
 }
 
 func addReplicaTagPropagates(configReplicas *schema.Set, replicas []interface{}) []interface{} {
-	if configReplicas.Len() == 0 {
-		return replicas
-	}
-
+	// Always set propagate_tags, even without configured replicas (e.g. on import),
+	// so that replica set elements hash the same as those read after apply.
 	l := configReplicas.List()
 
 	for i, replicaRaw := range replicas {
@@ -1897,10 +1919,11 @@ func flattenTableGlobalSecondaryIndex(gsi []*dynamodb.GlobalSecondaryIndexDescri
 
 		gsi := make(map[string]interface{})
 
+		gsi[names.AttrName] = aws.StringValue(g.IndexName)
+
 		if g.ProvisionedThroughput != nil {
 			gsi["write_capacity"] = aws.Int64Value(g.ProvisionedThroughput.WriteCapacityUnits)
 			gsi["read_capacity"] = aws.Int64Value(g.ProvisionedThroughput.ReadCapacityUnits)
-			gsi[names.AttrName] = aws.StringValue(g.IndexName)
 		}
 
 		for _, attribute := range g.KeySchema {
@@ -1968,9 +1991,101 @@ func flattenReplicaDescription(apiObject *dynamodb.ReplicaDescription) map[strin
 		tfMap["region_name"] = aws.StringValue(apiObject.RegionName)
 	}
 
+	tfMap["global_secondary_index"] = flattenReplicaGlobalSecondaryIndexDescriptions(apiObject.GlobalSecondaryIndexes)
+
 	return tfMap
 }
 
+func replicaGlobalSecondaryIndexSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"read_capacity_override": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// replicaGlobalSecondaryIndexesForUpdate returns the new replica GSI overrides along with
+// an entry without an override for each index whose override has been removed.
+func replicaGlobalSecondaryIndexesForUpdate(o, n *schema.Set) *schema.Set {
+	result := schema.NewSet(n.F, n.List())
+	configured := make(map[string]bool)
+
+	for _, tfMapRaw := range n.List() {
+		configured[tfMapRaw.(map[string]interface{})[names.AttrName].(string)] = true
+	}
+
+	for _, tfMapRaw := range o.List() {
+		if name := tfMapRaw.(map[string]interface{})[names.AttrName].(string); !configured[name] {
+			result.Add(map[string]interface{}{
+				names.AttrName:           name,
+				"read_capacity_override": 0,
+			})
+		}
+	}
+
+	return result
+}
+
+func expandReplicaGlobalSecondaryIndexes(tfList []interface{}) []*dynamodb.ReplicaGlobalSecondaryIndex {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*dynamodb.ReplicaGlobalSecondaryIndex
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &dynamodb.ReplicaGlobalSecondaryIndex{
+			IndexName: aws.String(tfMap[names.AttrName].(string)),
+		}
+
+		if v, ok := tfMap["read_capacity_override"].(int); ok && v > 0 {
+			apiObject.ProvisionedThroughputOverride = &dynamodb.ProvisionedThroughputOverride{
+				ReadCapacityUnits: aws.Int64(int64(v)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenReplicaGlobalSecondaryIndexDescriptions(apiObjects []*dynamodb.ReplicaGlobalSecondaryIndexDescription) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		// Only indexes with an override are configurable.
+		if apiObject == nil || apiObject.ProvisionedThroughputOverride == nil || apiObject.ProvisionedThroughputOverride.ReadCapacityUnits == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName:           aws.StringValue(apiObject.IndexName),
+			"read_capacity_override": aws.Int64Value(apiObject.ProvisionedThroughputOverride.ReadCapacityUnits),
+		})
+	}
+
+	return tfList
+}
+
 func flattenReplicaDescriptions(apiObjects []*dynamodb.ReplicaDescription) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
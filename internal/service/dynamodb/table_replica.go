@@ -58,7 +58,7 @@ func ResourceTableReplica() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			// global_secondary_index read capacity override can be set but not return by aws atm either through main/replica nor directly
+			"global_secondary_index": replicaGlobalSecondaryIndexSchema(), // through main table
 			"global_table_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -120,6 +120,10 @@ func resourceTableReplicaCreate(ctx context.Context, d *schema.ResourceData, met
 		replicaInput.KMSMasterKeyId = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("global_secondary_index"); ok && v.(*schema.Set).Len() > 0 {
+		replicaInput.GlobalSecondaryIndexes = expandReplicaGlobalSecondaryIndexes(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("table_class_override"); ok {
 		replicaInput.TableClassOverride = aws.String(v.(string))
 	}
@@ -262,6 +266,10 @@ func resourceTableReplicaRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set(names.AttrKMSKeyARN, replica.KMSMasterKeyId)
 	}
 
+	if err := d.Set("global_secondary_index", flattenReplicaGlobalSecondaryIndexDescriptions(replica.GlobalSecondaryIndexes)); err != nil {
+		return create.DiagSettingError(names.DynamoDB, ResNameTableReplica, d.Id(), "global_secondary_index", err)
+	}
+
 	if replica.ReplicaTableClassSummary != nil {
 		d.Set("table_class_override", replica.ReplicaTableClassSummary.TableClass)
 	} else {
@@ -394,6 +402,12 @@ func resourceTableReplicaUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	if d.HasChange("global_secondary_index") && !d.IsNewResource() {
+		o, n := d.GetChange("global_secondary_index")
+		viaMainChanges = true
+		viaMainInput.GlobalSecondaryIndexes = expandReplicaGlobalSecondaryIndexes(replicaGlobalSecondaryIndexesForUpdate(o.(*schema.Set), n.(*schema.Set)).List())
+	}
+
 	if viaMainChanges {
 		input := &dynamodb.UpdateTableInput{
 			ReplicaUpdates: []*dynamodb.ReplicationGroupUpdate{{
@@ -305,6 +305,52 @@ func TestAccDynamoDBTableReplica_tableClass(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTableReplica_globalSecondaryIndex(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	resourceName := "aws_dynamodb_table_replica.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 3),
+		CheckDestroy:             testAccCheckTableReplicaDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableReplicaConfig_globalSecondaryIndex(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableReplicaExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "global_secondary_index.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						"name":                   rName,
+						"read_capacity_override": "2",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableReplicaConfig_globalSecondaryIndex(rName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableReplicaExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "global_secondary_index.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						"name":                   rName,
+						"read_capacity_override": "3",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBTableReplica_keys(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -730,6 +776,109 @@ resource "aws_dynamodb_table_replica" "test" {
 `, rName, class))
 }
 
+func testAccTableReplicaConfig_globalSecondaryIndex(rName string, readCapacityOverride int) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(3),
+		fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  provider         = "awsalternate"
+  name             = %[1]q
+  hash_key         = "TestTableHashKey"
+  billing_mode     = "PROVISIONED"
+  read_capacity    = 1
+  write_capacity   = 1
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestGSIHashKey"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = %[1]q
+    hash_key        = "TestGSIHashKey"
+    projection_type = "ALL"
+    read_capacity   = 1
+    write_capacity  = 1
+  }
+
+  lifecycle {
+    ignore_changes = [replica, read_capacity, write_capacity, global_secondary_index]
+  }
+}
+
+# Replicas of provisioned tables require write capacity auto scaling.
+resource "aws_appautoscaling_target" "table" {
+  provider           = "awsalternate"
+  max_capacity       = 5
+  min_capacity       = 1
+  resource_id        = "table/${aws_dynamodb_table.test.name}"
+  scalable_dimension = "dynamodb:table:WriteCapacityUnits"
+  service_namespace  = "dynamodb"
+}
+
+resource "aws_appautoscaling_policy" "table" {
+  provider           = "awsalternate"
+  name               = "%[1]s-table"
+  policy_type        = "TargetTrackingScaling"
+  resource_id        = aws_appautoscaling_target.table.resource_id
+  scalable_dimension = aws_appautoscaling_target.table.scalable_dimension
+  service_namespace  = aws_appautoscaling_target.table.service_namespace
+
+  target_tracking_scaling_policy_configuration {
+    predefined_metric_specification {
+      predefined_metric_type = "DynamoDBWriteCapacityUtilization"
+    }
+
+    target_value = 70
+  }
+}
+
+resource "aws_appautoscaling_target" "index" {
+  provider           = "awsalternate"
+  max_capacity       = 5
+  min_capacity       = 1
+  resource_id        = "table/${aws_dynamodb_table.test.name}/index/%[1]s"
+  scalable_dimension = "dynamodb:index:WriteCapacityUnits"
+  service_namespace  = "dynamodb"
+}
+
+resource "aws_appautoscaling_policy" "index" {
+  provider           = "awsalternate"
+  name               = "%[1]s-index"
+  policy_type        = "TargetTrackingScaling"
+  resource_id        = aws_appautoscaling_target.index.resource_id
+  scalable_dimension = aws_appautoscaling_target.index.scalable_dimension
+  service_namespace  = aws_appautoscaling_target.index.service_namespace
+
+  target_tracking_scaling_policy_configuration {
+    predefined_metric_specification {
+      predefined_metric_type = "DynamoDBWriteCapacityUtilization"
+    }
+
+    target_value = 70
+  }
+}
+
+resource "aws_dynamodb_table_replica" "test" {
+  global_table_arn = aws_dynamodb_table.test.arn
+
+  global_secondary_index {
+    name                   = %[1]q
+    read_capacity_override = %[2]d
+  }
+
+  depends_on = [aws_appautoscaling_policy.table, aws_appautoscaling_policy.index]
+}
+`, rName, readCapacityOverride))
+}
+
 func testAccTableReplicaConfig_keys(rName, key string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigMultipleRegionProvider(2),
@@ -1918,6 +1918,57 @@ func TestAccDynamoDBTable_Replica_pitr(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_Replica_importGSITTLPITR(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf dynamodb.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 3), // 3 due to shared test configuration
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_replicaGSITTLPITR(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "global_secondary_index.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "point_in_time_recovery.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "point_in_time_recovery.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"point_in_time_recovery": "true",
+						"propagate_tags":         "false",
+						"region_name":            acctest.AlternateRegion(),
+					}),
+					resource.TestCheckResourceAttr(resourceName, "ttl.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ttl.0.attribute_name", "TestTTL"),
+					resource.TestCheckResourceAttr(resourceName, "ttl.0.enabled", "true"),
+				),
+			},
+			{
+				Config:            testAccTableConfig_replicaGSITTLPITR(rName),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccTableConfig_replicaGSITTLPITR(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBTable_Replica_pitrKMS(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -3859,6 +3910,66 @@ resource "aws_dynamodb_table" "test" {
 `, rName, mainPITR, replica1, replica2))
 }
 
+func testAccTableConfig_replicaGSITTLPITR(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(3), // Prevent "Provider configuration not present" errors
+		fmt.Sprintf(`
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_dynamodb_table" "test" {
+  name             = %[1]q
+  hash_key         = "TestTableHashKey"
+  billing_mode     = "PAY_PER_REQUEST"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestGSIHashKey1"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestGSIHashKey2"
+    type = "N"
+  }
+
+  global_secondary_index {
+    name            = "TestGSI1"
+    hash_key        = "TestGSIHashKey1"
+    projection_type = "ALL"
+  }
+
+  global_secondary_index {
+    name               = "TestGSI2"
+    hash_key           = "TestGSIHashKey2"
+    projection_type    = "INCLUDE"
+    non_key_attributes = ["TestNonKeyAttribute"]
+  }
+
+  ttl {
+    attribute_name = "TestTTL"
+    enabled        = true
+  }
+
+  point_in_time_recovery {
+    enabled = true
+  }
+
+  replica {
+    region_name            = data.aws_region.alternate.name
+    point_in_time_recovery = true
+  }
+}
+`, rName))
+}
+
 func testAccTableConfig_replicaPITRKMS(rName string, mainPITR, replica1, replica2 bool) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigMultipleRegionProvider(3),
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -36,6 +37,7 @@ func ResourceEBSSnapshot() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -53,6 +55,11 @@ func ResourceEBSSnapshot() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"enable_fast_restore_availability_zones": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"encrypted": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -164,6 +171,12 @@ func resourceEBSSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if v, ok := d.GetOk("enable_fast_restore_availability_zones"); ok && v.(*schema.Set).Len() > 0 {
+		if err := enableFastSnapshotRestores(ctx, conn, d.Id(), flex.ExpandStringValueSet(v.(*schema.Set)), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceEBSSnapshotRead(ctx, d, meta)...)
 }
 
@@ -203,6 +216,27 @@ func resourceEBSSnapshotRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("volume_id", snapshot.VolumeId)
 	d.Set("volume_size", snapshot.VolumeSize)
 
+	fastSnapshotRestores, err := FindFastSnapshotRestoresBySnapshotID(ctx, conn, d.Id())
+
+	switch {
+	// Don't fail refresh for callers without ec2:DescribeFastSnapshotRestores permission.
+	case tfawserr.ErrCodeEquals(err, errCodeUnauthorizedOperation):
+		diags = sdkdiag.AppendWarningf(diags, "reading EBS Snapshot (%s) fast snapshot restores: %s", d.Id(), err)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading EBS Snapshot (%s) fast snapshot restores: %s", d.Id(), err)
+	default:
+		var availabilityZones []string
+		for _, v := range fastSnapshotRestores {
+			// Zones in which fast snapshot restore is being disabled are no longer enabled.
+			if aws.StringValue(v.State) == ec2.FastSnapshotRestoreStateCodeDisabling {
+				continue
+			}
+
+			availabilityZones = append(availabilityZones, aws.StringValue(v.AvailabilityZone))
+		}
+		d.Set("enable_fast_restore_availability_zones", availabilityZones)
+	}
+
 	tags := KeyValueTags(ctx, snapshot.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -257,6 +291,23 @@ func resourceEBSSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChange("enable_fast_restore_availability_zones") {
+		o, n := d.GetChange("enable_fast_restore_availability_zones")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if del := flex.ExpandStringValueSet(os.Difference(ns)); len(del) > 0 {
+			if err := disableFastSnapshotRestores(ctx, conn, d.Id(), del, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
+		if add := flex.ExpandStringValueSet(ns.Difference(os)); len(add) > 0 {
+			if err := enableFastSnapshotRestores(ctx, conn, d.Id(), add, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -272,12 +323,19 @@ func resourceEBSSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
+	if v, ok := d.GetOk("enable_fast_restore_availability_zones"); ok && v.(*schema.Set).Len() > 0 {
+		if err := disableFastSnapshotRestores(ctx, conn, d.Id(), flex.ExpandStringValueSet(v.(*schema.Set)), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	log.Printf("[INFO] Deleting EBS Snapshot: %s", d.Id())
+	// Fast snapshot restores that are still enabling or optimizing may briefly hold on to the snapshot.
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
 		return conn.DeleteSnapshotWithContext(ctx, &ec2.DeleteSnapshotInput{
 			SnapshotId: aws.String(d.Id()),
 		})
-	}, errCodeInvalidSnapshotInUse)
+	}, errCodeInvalidSnapshotInUse, errCodeIncorrectState)
 
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidSnapshotNotFound) {
 		return diags
@@ -289,3 +347,59 @@ func resourceEBSSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	return diags
 }
+
+func enableFastSnapshotRestores(ctx context.Context, conn *ec2.EC2, snapshotID string, availabilityZones []string, timeout time.Duration) error {
+	input := &ec2.EnableFastSnapshotRestoresInput{
+		AvailabilityZones: aws.StringSlice(availabilityZones),
+		SourceSnapshotIds: aws.StringSlice([]string{snapshotID}),
+	}
+
+	log.Printf("[DEBUG] Enabling EBS Snapshot fast snapshot restores: %s", input)
+	output, err := conn.EnableFastSnapshotRestoresWithContext(ctx, input)
+
+	if err == nil && output != nil {
+		err = EnableFastSnapshotRestoresError(output.Unsuccessful)
+	}
+
+	if err != nil {
+		return fmt.Errorf("enabling EBS Snapshot (%s) fast snapshot restores: %w", snapshotID, err)
+	}
+
+	for _, availabilityZone := range availabilityZones {
+		if _, err := WaitFastSnapshotRestoreEnabled(ctx, conn, snapshotID, availabilityZone, timeout); err != nil {
+			return fmt.Errorf("waiting for EBS Snapshot (%s) fast snapshot restore (%s) enable: %w", snapshotID, availabilityZone, err)
+		}
+	}
+
+	return nil
+}
+
+func disableFastSnapshotRestores(ctx context.Context, conn *ec2.EC2, snapshotID string, availabilityZones []string, timeout time.Duration) error {
+	input := &ec2.DisableFastSnapshotRestoresInput{
+		AvailabilityZones: aws.StringSlice(availabilityZones),
+		SourceSnapshotIds: aws.StringSlice([]string{snapshotID}),
+	}
+
+	log.Printf("[DEBUG] Disabling EBS Snapshot fast snapshot restores: %s", input)
+	output, err := conn.DisableFastSnapshotRestoresWithContext(ctx, input)
+
+	if err == nil && output != nil {
+		err = DisableFastSnapshotRestoresError(output.Unsuccessful)
+	}
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidSnapshotNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("disabling EBS Snapshot (%s) fast snapshot restores: %w", snapshotID, err)
+	}
+
+	for _, availabilityZone := range availabilityZones {
+		if _, err := WaitFastSnapshotRestoreDisabled(ctx, conn, snapshotID, availabilityZone, timeout); err != nil {
+			return fmt.Errorf("waiting for EBS Snapshot (%s) fast snapshot restore (%s) disable: %w", snapshotID, availabilityZone, err)
+		}
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -28,6 +29,7 @@ func ResourceEBSSnapshotCopy() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -45,6 +47,11 @@ func ResourceEBSSnapshotCopy() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"enable_fast_restore_availability_zones": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"encrypted": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -166,5 +173,11 @@ func resourceEBSSnapshotCopyCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if v, ok := d.GetOk("enable_fast_restore_availability_zones"); ok && v.(*schema.Set).Len() > 0 {
+		if err := enableFastSnapshotRestores(ctx, conn, d.Id(), flex.ExpandStringValueSet(v.(*schema.Set)), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceEBSSnapshotRead(ctx, d, meta)...)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -101,6 +102,52 @@ func TestAccEC2EBSSnapshot_storageTier(t *testing.T) {
 	})
 }
 
+func TestAccEC2EBSSnapshot_enableFastRestore(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.Snapshot
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ebs_snapshot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEBSSnapshotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotConfig_enableFastRestore(rName, "data.aws_availability_zones.available.names[0]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_fast_restore_availability_zones.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "enable_fast_restore_availability_zones.*", "data.aws_availability_zones.available", "names.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEBSSnapshotConfig_enableFastRestore(rName, "data.aws_availability_zones.available.names[0]", "data.aws_availability_zones.available.names[1]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_fast_restore_availability_zones.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "enable_fast_restore_availability_zones.*", "data.aws_availability_zones.available", "names.0"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "enable_fast_restore_availability_zones.*", "data.aws_availability_zones.available", "names.1"),
+				),
+			},
+			{
+				Config: testAccEBSSnapshotConfig_enableFastRestore(rName, "data.aws_availability_zones.available.names[1]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_fast_restore_availability_zones.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "enable_fast_restore_availability_zones.*", "data.aws_availability_zones.available", "names.1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2EBSSnapshot_outpost(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v ec2.Snapshot
@@ -318,6 +365,20 @@ resource "aws_ebs_snapshot" "test" {
 `, rName, tier))
 }
 
+func testAccEBSSnapshotConfig_enableFastRestore(rName string, availabilityZones ...string) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ebs_snapshot" "test" {
+  volume_id = aws_ebs_volume.test.id
+
+  enable_fast_restore_availability_zones = [%[2]s]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, strings.Join(availabilityZones, ", ")))
+}
+
 func testAccEBSSnapshotConfig_outpost(rName string) string {
 	return acctest.ConfigCompose(testAccEBSSnapshotBaseConfig(rName), fmt.Sprintf(`
 data "aws_outposts_outposts" "test" {}
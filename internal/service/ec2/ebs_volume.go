@@ -392,6 +392,14 @@ func resourceEBSVolumeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff
 	} else {
 		// Update.
 
+		// EBS volumes can only be increased in size.
+		// A plan that also changes an argument that forces replacement creates a new volume, so any size is valid.
+		if diff.HasChange("size") && diff.NewValueKnown("size") && !diff.HasChanges("availability_zone", "encrypted", "kms_key_id", "multi_attach_enabled", "outpost_arn", "snapshot_id") {
+			if o, n := diff.GetChange("size"); n.(int) < o.(int) {
+				return fmt.Errorf("'size' cannot be decreased from %d to %d GiB, EBS volumes can only be increased in size", o.(int), n.(int))
+			}
+		}
+
 		// Setting 'iops = 0' is a no-op if the volume type does not require Iops to be specified.
 		if diff.HasChange("iops") && volumeType != ec2.VolumeTypeIo1 && volumeType != ec2.VolumeTypeIo2 && volumeType != ec2.VolumeTypeGp3 && iops == 0 {
 			return diff.Clear("iops")
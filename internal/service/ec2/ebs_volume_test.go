@@ -149,6 +149,19 @@ func TestAccEC2EBSVolume_updateSize(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "throughput", "0"),
 				),
 			},
+			{
+				Config:      testAccEBSVolumeConfig_tags1("Name", rName),
+				ExpectError: regexp.MustCompile(`'size' cannot be decreased from 10 to 1 GiB`),
+			},
+			// Decreasing size is allowed when the volume is replaced.
+			{
+				Config: testAccEBSVolumeConfig_updateSizeEncrypted(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "encrypted", "true"),
+					resource.TestCheckResourceAttr(resourceName, "size", "1"),
+				),
+			},
 		},
 	})
 }
@@ -1084,6 +1097,23 @@ resource "aws_ebs_volume" "test" {
 `, rName))
 }
 
+func testAccEBSVolumeConfig_updateSizeEncrypted(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  type              = "gp2"
+  size              = 1
+  encrypted         = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccEBSVolumeConfig_updateType(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAvailableAZsNoOptIn(),
@@ -54,6 +54,10 @@ func DataSourceEIP() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"nat_gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"network_interface_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -135,6 +139,20 @@ func dataSourceEIPRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("instance_id", eip.InstanceId)
 	d.Set("network_interface_id", eip.NetworkInterfaceId)
 	d.Set("network_interface_owner_id", eip.NetworkInterfaceOwnerId)
+
+	// Only look for a NAT gateway when the EIP is associated with a network interface.
+	d.Set("nat_gateway_id", nil)
+	if networkInterfaceID := aws.StringValue(eip.NetworkInterfaceId); networkInterfaceID != "" {
+		ng, err := FindNATGatewayByNetworkInterfaceID(ctx, conn, networkInterfaceID)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading EC2 NAT Gateway for EC2 Network Interface (%s): %s", networkInterfaceID, err)
+		default:
+			d.Set("nat_gateway_id", ng.NatGatewayId)
+		}
+	}
 	d.Set("public_ipv4_pool", eip.PublicIpv4Pool)
 
 	d.Set("private_ip", eip.PrivateIpAddress)
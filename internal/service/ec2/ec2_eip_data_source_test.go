@@ -122,6 +122,33 @@ func TestAccEC2EIPDataSource_networkInterface(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "private_dns", resourceName, "private_dns"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "private_ip", resourceName, "private_ip"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "domain", resourceName, "domain"),
+					resource.TestCheckResourceAttr(dataSourceName, "nat_gateway_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2EIPDataSource_natGateway(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_eip.test"
+	resourceName := "aws_eip.test"
+	natGatewayResourceName := "aws_nat_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEIPDataSourceConfig_natGateway(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "association_id", natGatewayResourceName, "association_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "nat_gateway_id", natGatewayResourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "network_interface_id", natGatewayResourceName, "network_interface_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "network_interface_owner_id"),
 				),
 			},
 		},
@@ -275,6 +302,41 @@ data "aws_eip" "test" {
 `)
 }
 
+func testAccEIPDataSourceConfig_natGateway(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_eip" "test" {
+  vpc = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_nat_gateway" "test" {
+  allocation_id = aws_eip.test.id
+  subnet_id     = aws_subnet.test[0].id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_internet_gateway.test]
+}
+
+data "aws_eip" "test" {
+  id = aws_nat_gateway.test.allocation_id
+}
+`, rName))
+}
+
 func testAccEIPDataSourceConfig_instance(rName string) string {
 	return acctest.ConfigCompose(testAccEIPConfig_instance(rName), `
 data "aws_eip" "test" {
@@ -229,18 +229,28 @@ func resourceHostDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[INFO] Deleting EC2 Host: %s", d.Id())
-	output, err := conn.ReleaseHostsWithContext(ctx, &ec2.ReleaseHostsInput{
-		HostIds: aws.StringSlice([]string{d.Id()}),
-	})
 
-	if err == nil && output != nil {
-		err = UnsuccessfulItemsError(output.Unsuccessful)
-	}
+	// Hosts can't be released while instances are still running on (or stopped on) them.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, HostReleaseRetryTimeout, func() (interface{}, error) {
+		output, err := conn.ReleaseHostsWithContext(ctx, &ec2.ReleaseHostsInput{
+			HostIds: aws.StringSlice([]string{d.Id()}),
+		})
+
+		if err == nil && output != nil {
+			err = UnsuccessfulItemsError(output.Unsuccessful)
+		}
+
+		return output, err
+	}, errCodeClientInvalidHostState)
 
 	if tfawserr.ErrCodeEquals(err, errCodeClientInvalidHostIDNotFound) {
 		return diags
 	}
 
+	if tfawserr.ErrCodeEquals(err, errCodeClientInvalidHostState) {
+		return sdkdiag.AppendErrorf(diags, "releasing EC2 Host (%s): host still has instances, terminate them or move them to another host first: %s", d.Id(), err)
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "releasing EC2 Host (%s): %s", d.Id(), err)
 	}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
@@ -459,7 +460,7 @@ func ResourceInstance() *schema.Resource {
 				Computed: true,
 			},
 			"network_interface": {
-				ConflictsWith: []string{"associate_public_ip_address", "subnet_id", "private_ip", "secondary_private_ips", "vpc_security_group_ids", "security_groups", "ipv6_addresses", "ipv6_address_count", "source_dest_check"},
+				ConflictsWith: []string{"associate_public_ip_address", "subnet_id", "private_ip", "secondary_private_ip_count", "secondary_private_ips", "vpc_security_group_ids", "security_groups", "ipv6_addresses", "ipv6_address_count", "source_dest_check"},
 				Type:          schema.TypeSet,
 				Optional:      true,
 				Computed:      true,
@@ -476,6 +477,7 @@ func ResourceInstance() *schema.Resource {
 							Required: true,
 							ForceNew: true,
 						},
+						"ena_srd_specification": enaSrdSpecificationSchema(),
 						"network_card_index": {
 							Type:     schema.TypeInt,
 							Optional: true,
@@ -489,6 +491,16 @@ func ResourceInstance() *schema.Resource {
 						},
 					},
 				},
+				// ena_srd_specification is excluded so that it can be updated in-place.
+				Set: func(v interface{}) int {
+					var buf bytes.Buffer
+					m := v.(map[string]interface{})
+					buf.WriteString(fmt.Sprintf("%t-", m["delete_on_termination"].(bool)))
+					buf.WriteString(fmt.Sprintf("%d-", m["device_index"].(int)))
+					buf.WriteString(fmt.Sprintf("%d-", m["network_card_index"].(int)))
+					buf.WriteString(fmt.Sprintf("%s-", m["network_interface_id"].(string)))
+					return create.StringHashcode(buf.String())
+				},
 			},
 			"outpost_arn": {
 				Type:     schema.TypeString,
@@ -622,6 +634,13 @@ func ResourceInstance() *schema.Resource {
 					},
 				},
 			},
+			"secondary_private_ip_count": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.IntAtLeast(0),
+				ConflictsWith: []string{"secondary_private_ips"},
+			},
 			"secondary_private_ips": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -630,6 +649,7 @@ func ResourceInstance() *schema.Resource {
 					Type:         schema.TypeString,
 					ValidateFunc: validation.IsIPv4Address,
 				},
+				ConflictsWith: []string{"secondary_private_ip_count"},
 			},
 			"security_groups": {
 				Type:     schema.TypeSet,
@@ -775,6 +795,12 @@ func ResourceInstance() *schema.Resource {
 			customdiff.ComputedIf("launch_template.0.name", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("launch_template.0.id")
 			}),
+			customdiff.ComputedIf("secondary_private_ips", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.Id() != "" && diff.HasChange("secondary_private_ip_count")
+			}),
+			customdiff.ComputedIf("secondary_private_ip_count", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.Id() != "" && diff.HasChange("secondary_private_ips")
+			}),
 			customdiff.ForceNewIf("user_data", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.Get("user_data_replace_on_change").(bool)
 			}),
@@ -888,6 +914,27 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Instance (%s) create: %s", d.Id(), err)
 	}
 
+	// Secondary private IPs can only be requested from RunInstances via network interface specifications,
+	// which aren't used without subnet_id or network_interface. Assign them to the default primary network interface instead.
+	if instanceOpts.NetworkInterfaces == nil {
+		if err := assignInstancePrimaryNetworkInterfaceSecondaryPrivateIPs(ctx, conn, d, instance); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 Instance (%s): %s", d.Id(), err)
+		}
+	}
+
+	// RunInstances doesn't support ENA Express, so configure it on the attached network interfaces.
+	if v, ok := d.GetOk("network_interface"); ok {
+		for _, v := range v.(*schema.Set).List() {
+			tfMap := v.(map[string]interface{})
+
+			if v := tfMap["ena_srd_specification"].([]interface{}); len(v) > 0 {
+				if err := modifyNetworkInterfaceEnaSrdSpecification(ctx, conn, tfMap["network_interface_id"].(string), v); err != nil {
+					return sdkdiag.AppendErrorf(diags, "creating EC2 Instance (%s): %s", d.Id(), err)
+				}
+			}
+		}
+	}
+
 	// Initialize the connection info
 	if instance.PublicIpAddress != nil {
 		d.SetConnInfo(map[string]string{
@@ -1052,11 +1099,13 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	// resources have the potential to attach network interfaces to the instance, and cause a perpetual create/destroy
 	// diff. We should only read on changes configured for this specific resource because of this.
 	var configuredDeviceIndexes []int
+	configuredEnaSrdSpecifications := make(map[string][]interface{})
 	if v, ok := d.GetOk("network_interface"); ok {
 		vL := v.(*schema.Set).List()
 		for _, vi := range vL {
 			mVi := vi.(map[string]interface{})
 			configuredDeviceIndexes = append(configuredDeviceIndexes, mVi["device_index"].(int))
+			configuredEnaSrdSpecifications[mVi["network_interface_id"].(string)] = mVi["ena_srd_specification"].([]interface{})
 		}
 	}
 
@@ -1084,6 +1133,25 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 			if len(ni) == 0 {
 				continue
 			}
+			// The ENA SRD specification is only returned by DescribeNetworkInterfaces.
+			// If it can't be read, keep the value in state.
+			networkInterfaceID := aws.StringValue(iNi.NetworkInterfaceId)
+			configuredEnaSrdSpecification := configuredEnaSrdSpecifications[networkInterfaceID]
+			networkInterface, err := FindNetworkInterfaceByID(ctx, conn, networkInterfaceID)
+
+			switch {
+			case tfresource.NotFound(err):
+				log.Printf("[WARN] EC2 Instance (%s) network interface (%s) not found, keeping ENA SRD specification in state", d.Id(), networkInterfaceID)
+				ni["ena_srd_specification"] = configuredEnaSrdSpecification
+			// Don't fail refresh for callers without ec2:DescribeNetworkInterfaces permission.
+			case tfawserr.ErrCodeEquals(err, errCodeUnauthorizedOperation):
+				diags = sdkdiag.AppendWarningf(diags, "reading EC2 Instance (%s) network interface (%s): %s", d.Id(), networkInterfaceID, err)
+				ni["ena_srd_specification"] = configuredEnaSrdSpecification
+			case err != nil:
+				return sdkdiag.AppendErrorf(diags, "reading EC2 Instance (%s) network interface (%s): %s", d.Id(), networkInterfaceID, err)
+			case networkInterface.Attachment != nil:
+				ni["ena_srd_specification"] = flattenAttachmentEnaSrdSpecificationState(networkInterface.Attachment.EnaSrdSpecification, configuredEnaSrdSpecification)
+			}
 			networkInterfaces = append(networkInterfaces, ni)
 		}
 		if err := d.Set("network_interface", networkInterfaces); err != nil {
@@ -1124,6 +1192,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 		d.Set("subnet_id", instance.SubnetId)
 	}
 
+	d.Set("secondary_private_ip_count", len(secondaryPrivateIPs))
 	if err := d.Set("secondary_private_ips", secondaryPrivateIPs); err != nil {
 		return sdkdiag.AppendErrorf(diags, "Error setting private_ips for AWS Instance (%s): %s", d.Id(), err)
 	}
@@ -1396,6 +1465,32 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("network_interface") && !d.IsNewResource() {
+		o, n := d.GetChange("network_interface")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		oldEnaSrdSpecifications := make(map[string][]interface{})
+		for _, v := range os.List() {
+			tfMap := v.(map[string]interface{})
+			oldEnaSrdSpecifications[tfMap["network_interface_id"].(string)] = tfMap["ena_srd_specification"].([]interface{})
+		}
+
+		for _, v := range ns.List() {
+			tfMap := v.(map[string]interface{})
+			networkInterfaceID := tfMap["network_interface_id"].(string)
+			enaSrdSpecification := tfMap["ena_srd_specification"].([]interface{})
+			oldEnaSrdSpecification, ok := oldEnaSrdSpecifications[networkInterfaceID]
+
+			if (!ok && len(enaSrdSpecification) == 0) || reflect.DeepEqual(oldEnaSrdSpecification, enaSrdSpecification) {
+				continue
+			}
+
+			if err := modifyNetworkInterfaceEnaSrdSpecification(ctx, conn, networkInterfaceID, enaSrdSpecification); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
 	// SourceDestCheck can only be modified on an instance without manually specified network interfaces.
 	// SourceDestCheck, in that case, is configured at the network interface level
 	if _, ok := d.GetOk("network_interface"); !ok {
@@ -1419,7 +1514,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChanges("secondary_private_ips", "vpc_security_group_ids") && !d.IsNewResource() {
+	if d.HasChanges("secondary_private_ip_count", "secondary_private_ips", "vpc_security_group_ids") && !d.IsNewResource() {
 		instance, err := FindInstanceByID(ctx, conn, d.Id())
 
 		if err != nil {
@@ -1433,7 +1528,48 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 		}
 
-		if d.HasChange("secondary_private_ips") {
+		if v := d.GetRawConfig().GetAttr("secondary_private_ip_count"); d.HasChange("secondary_private_ip_count") && v.IsKnown() && !v.IsNull() {
+			if primaryInterface == nil || primaryInterface.NetworkInterfaceId == nil {
+				return sdkdiag.AppendErrorf(diags, "Failed to update secondary_private_ip_count on %q, which does not contain a primary network interface",
+					d.Id())
+			}
+
+			o, n := d.GetChange("secondary_private_ip_count")
+			oCount, nCount := o.(int), n.(int)
+
+			if nCount > oCount {
+				input := &ec2.AssignPrivateIpAddressesInput{
+					NetworkInterfaceId:             primaryInterface.NetworkInterfaceId,
+					SecondaryPrivateIpAddressCount: aws.Int64(int64(nCount - oCount)),
+				}
+
+				log.Printf("[INFO] Assigning secondary_private_ip_count on Instance %q", d.Id())
+				if _, err := conn.AssignPrivateIpAddressesWithContext(ctx, input); err != nil {
+					return sdkdiag.AppendErrorf(diags, "Failure to assign Secondary Private IPs: %s", err)
+				}
+			} else if nCount < oCount {
+				var secondaryPrivateIPs []string
+				for _, address := range primaryInterface.PrivateIpAddresses {
+					if !aws.BoolValue(address.Primary) {
+						secondaryPrivateIPs = append(secondaryPrivateIPs, aws.StringValue(address.PrivateIpAddress))
+					}
+				}
+
+				if count := oCount - nCount; count < len(secondaryPrivateIPs) {
+					secondaryPrivateIPs = secondaryPrivateIPs[len(secondaryPrivateIPs)-count:]
+				}
+
+				input := &ec2.UnassignPrivateIpAddressesInput{
+					NetworkInterfaceId: primaryInterface.NetworkInterfaceId,
+					PrivateIpAddresses: aws.StringSlice(secondaryPrivateIPs),
+				}
+
+				log.Printf("[INFO] Unassigning secondary_private_ip_count on Instance %q", d.Id())
+				if _, err := conn.UnassignPrivateIpAddressesWithContext(ctx, input); err != nil {
+					return sdkdiag.AppendErrorf(diags, "Failure to unassign Secondary Private IPs: %s", err)
+				}
+			}
+		} else if d.HasChange("secondary_private_ips") {
 			if primaryInterface == nil || primaryInterface.NetworkInterfaceId == nil {
 				return sdkdiag.AppendErrorf(diags, "Failed to update secondary_private_ips on %q, which does not contain a primary network interface",
 					d.Id())
@@ -1508,6 +1644,13 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	// Stop protection must be removed before any change that stops the instance.
+	if d.HasChange("disable_api_stop") && !d.IsNewResource() && !d.Get("disable_api_stop").(bool) {
+		if err := disableInstanceAPIStop(ctx, conn, d.Id(), false); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChanges("instance_type", "user_data", "user_data_base64") && !d.IsNewResource() {
 		// For each argument change, we start and stop the instance
 		// to account for behaviors occurring outside terraform.
@@ -1579,8 +1722,8 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChange("disable_api_stop") && !d.IsNewResource() {
-		if err := disableInstanceAPIStop(ctx, conn, d.Id(), d.Get("disable_api_stop").(bool)); err != nil {
+	if d.HasChange("disable_api_stop") && !d.IsNewResource() && d.Get("disable_api_stop").(bool) {
+		if err := disableInstanceAPIStop(ctx, conn, d.Id(), true); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): %s", d.Id(), err)
 		}
 	}
@@ -2167,6 +2310,36 @@ func FetchRootDeviceName(ctx context.Context, conn *ec2.EC2, amiID string) (*str
 	return rootDeviceName, nil
 }
 
+// assignInstancePrimaryNetworkInterfaceSecondaryPrivateIPs assigns the configured secondary private IPs to the instance's primary network interface.
+func assignInstancePrimaryNetworkInterfaceSecondaryPrivateIPs(ctx context.Context, conn *ec2.EC2, d *schema.ResourceData, instance *ec2.Instance) error {
+	input := &ec2.AssignPrivateIpAddressesInput{}
+
+	if v, ok := d.GetOk("secondary_private_ip_count"); ok && v.(int) > 0 {
+		input.SecondaryPrivateIpAddressCount = aws.Int64(int64(v.(int)))
+	} else if v, ok := d.GetOk("secondary_private_ips"); ok && v.(*schema.Set).Len() > 0 {
+		input.PrivateIpAddresses = flex.ExpandStringSet(v.(*schema.Set))
+	} else {
+		return nil
+	}
+
+	for _, v := range instance.NetworkInterfaces {
+		if v.Attachment != nil && aws.Int64Value(v.Attachment.DeviceIndex) == 0 {
+			input.NetworkInterfaceId = v.NetworkInterfaceId
+		}
+	}
+
+	if input.NetworkInterfaceId == nil {
+		return fmt.Errorf("assigning secondary private IPs: primary network interface not found")
+	}
+
+	log.Printf("[INFO] Assigning EC2 Instance (%s) secondary private IPs: %s", d.Id(), input)
+	if _, err := conn.AssignPrivateIpAddressesWithContext(ctx, input); err != nil {
+		return fmt.Errorf("assigning secondary private IPs: %w", err)
+	}
+
+	return nil
+}
+
 func buildNetworkInterfaceOpts(d *schema.ResourceData, groups []*string, nInterfaces interface{}) []*ec2.InstanceNetworkInterfaceSpecification {
 	networkInterfaces := []*ec2.InstanceNetworkInterfaceSpecification{}
 	// Get necessary items
@@ -2198,6 +2371,10 @@ func buildNetworkInterfaceOpts(d *schema.ResourceData, groups []*string, nInterf
 			ni.PrivateIpAddresses = expandSecondaryPrivateIPAddresses(v.(*schema.Set).List())
 		}
 
+		if v, ok := d.GetOk("secondary_private_ip_count"); ok {
+			ni.SecondaryPrivateIpAddressCount = aws.Int64(int64(v.(int)))
+		}
+
 		if v, ok := d.GetOk("ipv6_address_count"); ok {
 			ni.Ipv6AddressCount = aws.Int64(int64(v.(int)))
 		}
@@ -2805,6 +2982,10 @@ func StopInstance(ctx context.Context, conn *ec2.EC2, id string, timeout time.Du
 		InstanceIds: aws.StringSlice([]string{id}),
 	})
 
+	if tfawserr.ErrMessageContains(err, errCodeOperationNotPermitted, "disableApiStop") {
+		return fmt.Errorf("stopping EC2 Instance: stop protection is enabled, set disable_api_stop to false to allow the instance to be stopped: %w", err)
+	}
+
 	if err != nil {
 		return fmt.Errorf("stopping EC2 Instance: %w", err)
 	}
@@ -2,11 +2,13 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -151,6 +153,10 @@ func StopInstanceWithContext(ctx context.Context, conn *ec2.EC2, id string, forc
 		Force:       aws.Bool(force),
 	})
 
+	if tfawserr.ErrMessageContains(err, errCodeOperationNotPermitted, "disableApiStop") {
+		return create.DiagError(names.EC2, "stopping Instance", ResInstance, id, fmt.Errorf("stop protection is enabled, set disable_api_stop to false on the instance to allow it to be stopped: %w", err))
+	}
+
 	if err != nil {
 		return create.DiagError(names.EC2, "stopping Instance", ResInstance, id, err)
 	}
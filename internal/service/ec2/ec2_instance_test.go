@@ -1067,6 +1067,42 @@ func TestAccEC2Instance_disableAPIStop(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_DisableAPIStop_instanceType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_disableAPIStopInstanceType(rName, true, "t2.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable_api_stop", "true"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t2.micro"),
+				),
+			},
+			{
+				Config:      testAccInstanceConfig_disableAPIStopInstanceType(rName, true, "t2.small"),
+				ExpectError: regexp.MustCompile(`stop protection is enabled`),
+			},
+			{
+				Config: testAccInstanceConfig_disableAPIStopInstanceType(rName, false, "t2.small"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable_api_stop", "false"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t2.small"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_disableAPITerminationFinalFalse(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v ec2.Instance
@@ -2746,6 +2782,45 @@ func TestAccEC2Instance_primaryNetworkInterface(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_NetworkInterface_enaSrdSpecification(t *testing.T) {
+	ctx := acctest.Context(t)
+	var instance ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_networkInterfaceEnaSrdSpecification(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "network_interface.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "network_interface.*", map[string]string{
+						"device_index":                            "0",
+						"ena_srd_specification.#":                 "1",
+						"ena_srd_specification.0.ena_srd_enabled": "true",
+					}),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_networkInterfaceEnaSrdSpecification(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &instance),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "network_interface.*", map[string]string{
+						"device_index":                            "0",
+						"ena_srd_specification.#":                 "1",
+						"ena_srd_specification.0.ena_srd_enabled": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_networkCardIndex(t *testing.T) {
 	ctx := acctest.Context(t)
 	var instance ec2.Instance
@@ -3100,6 +3175,82 @@ func TestAccEC2Instance_NewNetworkInterface_privateIPAndSecondaryPrivateIPsUpdat
 	})
 }
 
+func TestAccEC2Instance_secondaryPrivateIPCountDefaultVPC(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		// No subnet_id specified requires default VPC with default subnets.
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckHasDefaultVPCDefaultSubnets(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_secondaryPrivateIPCountDefaultVPC(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ips.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2Instance_NewNetworkInterface_secondaryPrivateIPCountUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_secondaryPrivateIPCount(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ips.#", "2"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_secondaryPrivateIPCount(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ips.#", "3"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_secondaryPrivateIPCount(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ips.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"user_data_replace_on_change"},
+			},
+		},
+	})
+}
+
 // https://github.com/hashicorp/terraform-provider-aws/issues/227
 func TestAccEC2Instance_AssociatePublic_defaultPrivate(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -5891,6 +6042,24 @@ resource "aws_instance" "test" {
 `, rName, val))
 }
 
+func testAccInstanceConfig_disableAPIStopInstanceType(rName string, val bool, instanceType string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami              = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type    = %[3]q
+  subnet_id        = aws_subnet.test.id
+  disable_api_stop = %[2]t
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, val, instanceType))
+}
+
 func testAccInstanceConfig_disableAPITermination(rName string, val bool) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
@@ -7048,6 +7217,42 @@ resource "aws_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_networkInterfaceEnaSrdSpecification(rName string, enaSrdEnabled bool) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		// ENA Express is only supported on a subset of the largest instance sizes.
+		acctest.AvailableEC2InstanceTypeForRegion("c6in.32xlarge", "m6i.32xlarge"),
+		fmt.Sprintf(`
+resource "aws_network_interface" "test" {
+  subnet_id   = aws_subnet.test.id
+  private_ips = ["10.1.1.42"]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  network_interface {
+    network_interface_id = aws_network_interface.test.id
+    device_index         = 0
+
+    ena_srd_specification {
+      ena_srd_enabled = %[2]t
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, enaSrdEnabled))
+}
+
 func testAccInstanceConfig_networkCardIndex(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
@@ -7376,6 +7581,54 @@ resource "aws_instance" "test" {
 `, rName, privateIP, secondaryIPs))
 }
 
+func testAccInstanceConfig_secondaryPrivateIPCountDefaultVPC(rName string, count int) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAvailableAZsNoOptInDefaultExclude(),
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami               = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type     = "t3.small"
+  availability_zone = data.aws_availability_zones.available.names[0]
+
+  secondary_private_ip_count = %[2]d
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, count))
+}
+
+func testAccInstanceConfig_secondaryPrivateIPCount(rName string, count int) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  vpc_id      = aws_vpc.test.id
+  description = %[1]q
+  name        = %[1]q
+}
+
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = "t3.small"
+  subnet_id     = aws_subnet.test.id
+
+  secondary_private_ip_count = %[2]d
+
+  vpc_security_group_ids = [
+    aws_security_group.test.id
+  ]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, count))
+}
+
 func testAccInstanceConfig_privateIPAndSecondaryIPsNullPrivate(rName, secondaryIPs string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
@@ -333,278 +333,7 @@ func ResourceLaunchTemplate() *schema.Resource {
 					},
 				},
 			},
-			"instance_requirements": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"accelerator_count": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"max": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(0),
-									},
-									"min": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-						"accelerator_manufacturers": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice(ec2.AcceleratorManufacturer_Values(), false),
-							},
-						},
-						"accelerator_names": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice(ec2.AcceleratorName_Values(), false),
-							},
-						},
-						"accelerator_total_memory_mib": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"max": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-									"min": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-						"accelerator_types": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice(ec2.AcceleratorType_Values(), false),
-							},
-						},
-						"allowed_instance_types": {
-							Type:          schema.TypeSet,
-							Optional:      true,
-							MaxItems:      400,
-							Elem:          &schema.Schema{Type: schema.TypeString},
-							ConflictsWith: []string{"instance_requirements.0.excluded_instance_types"},
-						},
-						"bare_metal": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringInSlice(ec2.BareMetal_Values(), false),
-						},
-						"baseline_ebs_bandwidth_mbps": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"max": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-									"min": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-						"burstable_performance": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringInSlice(ec2.BurstablePerformance_Values(), false),
-						},
-						"cpu_manufacturers": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice(ec2.CpuManufacturer_Values(), false),
-							},
-						},
-						"excluded_instance_types": {
-							Type:          schema.TypeSet,
-							Optional:      true,
-							MaxItems:      400,
-							Elem:          &schema.Schema{Type: schema.TypeString},
-							ConflictsWith: []string{"instance_requirements.0.allowed_instance_types"},
-						},
-						"instance_generations": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice(ec2.InstanceGeneration_Values(), false),
-							},
-						},
-						"local_storage": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringInSlice(ec2.LocalStorage_Values(), false),
-						},
-						"local_storage_types": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice(ec2.LocalStorageType_Values(), false),
-							},
-						},
-						"memory_gib_per_vcpu": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"max": {
-										Type:         schema.TypeFloat,
-										Optional:     true,
-										ValidateFunc: verify.FloatGreaterThan(0.0),
-									},
-									"min": {
-										Type:         schema.TypeFloat,
-										Optional:     true,
-										ValidateFunc: verify.FloatGreaterThan(0.0),
-									},
-								},
-							},
-						},
-						"memory_mib": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"max": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-									"min": {
-										Type:         schema.TypeInt,
-										Required:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-						"network_bandwidth_gbps": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"max": {
-										Type:         schema.TypeFloat,
-										Optional:     true,
-										ValidateFunc: verify.FloatGreaterThan(0.0),
-									},
-									"min": {
-										Type:         schema.TypeFloat,
-										Optional:     true,
-										ValidateFunc: verify.FloatGreaterThan(0.0),
-									},
-								},
-							},
-						},
-						"network_interface_count": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"max": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-									"min": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-						"on_demand_max_price_percentage_over_lowest_price": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntAtLeast(1),
-						},
-						"require_hibernate_support": {
-							Type:     schema.TypeBool,
-							Optional: true,
-						},
-						"spot_max_price_percentage_over_lowest_price": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntAtLeast(1),
-						},
-						"total_local_storage_gb": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"max": {
-										Type:         schema.TypeFloat,
-										Optional:     true,
-										ValidateFunc: verify.FloatGreaterThan(0.0),
-									},
-									"min": {
-										Type:         schema.TypeFloat,
-										Optional:     true,
-										ValidateFunc: verify.FloatGreaterThan(0.0),
-									},
-								},
-							},
-						},
-						"vcpu_count": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"max": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-									"min": {
-										Type:         schema.TypeInt,
-										Required:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-					},
-				},
-				ConflictsWith: []string{"instance_type"},
-			},
+			"instance_requirements": launchTemplateInstanceRequirementsSchema(),
 			"instance_type": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -1572,6 +1301,291 @@ func expandLaunchTemplateInstanceMarketOptionsRequest(tfMap map[string]interface
 	return apiObject
 }
 
+// instanceRequirementsSchema returns the schema for an instance_requirements block,
+// shared by launch templates and EC2 Fleet launch template overrides.
+func instanceRequirementsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"accelerator_count": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+							},
+							"min": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
+				"accelerator_manufacturers": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(ec2.AcceleratorManufacturer_Values(), false),
+					},
+				},
+				"accelerator_names": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(ec2.AcceleratorName_Values(), false),
+					},
+				},
+				"accelerator_total_memory_mib": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"min": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
+				"accelerator_types": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(ec2.AcceleratorType_Values(), false),
+					},
+				},
+				"allowed_instance_types": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					MaxItems: 400,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"bare_metal": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(ec2.BareMetal_Values(), false),
+				},
+				"baseline_ebs_bandwidth_mbps": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"min": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
+				"burstable_performance": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(ec2.BurstablePerformance_Values(), false),
+				},
+				"cpu_manufacturers": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(ec2.CpuManufacturer_Values(), false),
+					},
+				},
+				"excluded_instance_types": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					MaxItems: 400,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"instance_generations": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(ec2.InstanceGeneration_Values(), false),
+					},
+				},
+				"local_storage": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(ec2.LocalStorage_Values(), false),
+				},
+				"local_storage_types": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(ec2.LocalStorageType_Values(), false),
+					},
+				},
+				"memory_gib_per_vcpu": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max": {
+								Type:         schema.TypeFloat,
+								Optional:     true,
+								ValidateFunc: verify.FloatGreaterThan(0.0),
+							},
+							"min": {
+								Type:         schema.TypeFloat,
+								Optional:     true,
+								ValidateFunc: verify.FloatGreaterThan(0.0),
+							},
+						},
+					},
+				},
+				"memory_mib": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"min": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
+				"network_bandwidth_gbps": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max": {
+								Type:         schema.TypeFloat,
+								Optional:     true,
+								ValidateFunc: verify.FloatGreaterThan(0.0),
+							},
+							"min": {
+								Type:         schema.TypeFloat,
+								Optional:     true,
+								ValidateFunc: verify.FloatGreaterThan(0.0),
+							},
+						},
+					},
+				},
+				"network_interface_count": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"min": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
+				"on_demand_max_price_percentage_over_lowest_price": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"require_hibernate_support": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"spot_max_price_percentage_over_lowest_price": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"total_local_storage_gb": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max": {
+								Type:         schema.TypeFloat,
+								Optional:     true,
+								ValidateFunc: verify.FloatGreaterThan(0.0),
+							},
+							"min": {
+								Type:         schema.TypeFloat,
+								Optional:     true,
+								ValidateFunc: verify.FloatGreaterThan(0.0),
+							},
+						},
+					},
+				},
+				"vcpu_count": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"min": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func launchTemplateInstanceRequirementsSchema() *schema.Schema {
+	s := instanceRequirementsSchema()
+	s.ConflictsWith = []string{"instance_type"}
+
+	r := s.Elem.(*schema.Resource)
+	r.Schema["allowed_instance_types"].ConflictsWith = []string{"instance_requirements.0.excluded_instance_types"}
+	r.Schema["excluded_instance_types"].ConflictsWith = []string{"instance_requirements.0.allowed_instance_types"}
+
+	return s
+}
+
 func expandInstanceRequirementsRequest(tfMap map[string]interface{}) *ec2.InstanceRequirementsRequest {
 	if tfMap == nil {
 		return nil
@@ -1112,12 +1112,10 @@ func resourceSpotFleetRequestRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceSpotFleetRequestUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var
-	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_ModifySpotFleetRequest.html
-	diags diag.Diagnostics
-
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_ModifySpotFleetRequest.html
 	if d.HasChangesExcept("tags", "tags_all") {
 		input := &ec2.ModifySpotFleetRequestInput{
 			SpotFleetRequestId: aws.String(d.Id()),
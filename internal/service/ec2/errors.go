@@ -12,6 +12,7 @@ import (
 const (
 	errCodeAuthFailure                                       = "AuthFailure"
 	errCodeClientInvalidHostIDNotFound                       = "Client.InvalidHostID.NotFound"
+	errCodeClientInvalidHostState                            = "Client.InvalidHostState"
 	ErrCodeDefaultSubnetAlreadyExistsInAvailabilityZone      = "DefaultSubnetAlreadyExistsInAvailabilityZone"
 	errCodeDependencyViolation                               = "DependencyViolation"
 	errCodeGatewayNotAttached                                = "Gateway.NotAttached"
@@ -102,9 +103,11 @@ const (
 	errCodeInvalidVPNGatewayAttachmentNotFound               = "InvalidVpnGatewayAttachment.NotFound"
 	errCodeInvalidVPNGatewayIDNotFound                       = "InvalidVpnGatewayID.NotFound"
 	errCodeNatGatewayNotFound                                = "NatGatewayNotFound"
+	errCodeOperationNotPermitted                             = "OperationNotPermitted"
 	errCodePrefixListVersionMismatch                         = "PrefixListVersionMismatch"
 	errCodeResourceNotReady                                  = "ResourceNotReady"
 	errCodeSnapshotCreationPerVolumeRateExceeded             = "SnapshotCreationPerVolumeRateExceeded"
+	errCodeUnauthorizedOperation                             = "UnauthorizedOperation"
 	errCodeUnsupportedOperation                              = "UnsupportedOperation"
 	errCodeVolumeInUse                                       = "VolumeInUse"
 )
@@ -129,6 +132,58 @@ func CancelSpotFleetRequestsError(apiObjects []*ec2.CancelSpotFleetRequestsError
 	return errors.ErrorOrNil()
 }
 
+func DisableFastSnapshotRestoreStateErrorItemError(apiObject *ec2.DisableFastSnapshotRestoreStateErrorItem) error {
+	if apiObject == nil || apiObject.Error == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.Error.Code), aws.StringValue(apiObject.Error.Message), nil)
+}
+
+func DisableFastSnapshotRestoresError(apiObjects []*ec2.DisableFastSnapshotRestoreErrorItem) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		for _, v := range apiObject.FastSnapshotRestoreStateErrors {
+			if err := DisableFastSnapshotRestoreStateErrorItemError(v); err != nil {
+				errors = multierror.Append(errors, fmt.Errorf("%s (%s): %w", aws.StringValue(apiObject.SnapshotId), aws.StringValue(v.AvailabilityZone), err))
+			}
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
+func EnableFastSnapshotRestoreStateErrorItemError(apiObject *ec2.EnableFastSnapshotRestoreStateErrorItem) error {
+	if apiObject == nil || apiObject.Error == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.Error.Code), aws.StringValue(apiObject.Error.Message), nil)
+}
+
+func EnableFastSnapshotRestoresError(apiObjects []*ec2.EnableFastSnapshotRestoreErrorItem) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		for _, v := range apiObject.FastSnapshotRestoreStateErrors {
+			if err := EnableFastSnapshotRestoreStateErrorItemError(v); err != nil {
+				errors = multierror.Append(errors, fmt.Errorf("%s (%s): %w", aws.StringValue(apiObject.SnapshotId), aws.StringValue(v.AvailabilityZone), err))
+			}
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
 func DeleteFleetError(apiObject *ec2.DeleteFleetErrorItem) error {
 	if apiObject == nil || apiObject.Error == nil {
 		return nil
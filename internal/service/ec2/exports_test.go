@@ -2,6 +2,7 @@ package ec2
 
 // Exports for use in tests only.
 var (
+	SetNATGatewayAddresses           = setNATGatewayAddresses
 	ResourceSecurityGroupEgressRule  = newResourceSecurityGroupEgressRule
 	ResourceSecurityGroupIngressRule = newResourceSecurityGroupIngressRule
 )
@@ -5740,6 +5740,36 @@ func FindIPAMPoolAllocationByTwoPartKey(ctx context.Context, conn *ec2.EC2, allo
 	return output, nil
 }
 
+func FindIPAMPoolAllocationByPoolIDAndCIDR(ctx context.Context, conn *ec2.EC2, poolID, cidr string) (*ec2.IpamPoolAllocation, error) {
+	input := &ec2.GetIpamPoolAllocationsInput{
+		IpamPoolId: aws.String(poolID),
+	}
+
+	allocations, err := FindIPAMPoolAllocations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []*ec2.IpamPoolAllocation
+
+	for _, v := range allocations {
+		if aws.StringValue(v.Cidr) == cidr {
+			output = append(output, v)
+		}
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
 func FindIPAMPoolCIDR(ctx context.Context, conn *ec2.EC2, input *ec2.GetIpamPoolCidrsInput) (*ec2.IpamPoolCidr, error) {
 	output, err := FindIPAMPoolCIDRs(ctx, conn, input)
 
@@ -6489,6 +6519,76 @@ func FindNATGatewayByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.Nat
 	return output, nil
 }
 
+func FindNATGatewayByNetworkInterfaceID(ctx context.Context, conn *ec2.EC2, networkInterfaceID string) (*ec2.NatGateway, error) {
+	networkInterface, err := FindNetworkInterfaceByID(ctx, conn, networkInterfaceID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.StringValue(networkInterface.InterfaceType) != ec2.NetworkInterfaceTypeNatGateway {
+		return nil, &resource.NotFoundError{}
+	}
+
+	// DescribeNatGateways has no network interface ID filter, so only list the NAT gateways in the network interface's subnet.
+	input := &ec2.DescribeNatGatewaysInput{
+		Filter: BuildAttributeFilterList(map[string]string{
+			"state":     ec2.NatGatewayStateAvailable,
+			"subnet-id": aws.StringValue(networkInterface.SubnetId),
+		}),
+	}
+
+	output, err := FindNATGateways(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output {
+		for _, address := range v.NatGatewayAddresses {
+			if aws.StringValue(address.NetworkInterfaceId) == networkInterfaceID {
+				return v, nil
+			}
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func FindNATGatewayAddressByNATGatewayIDAndAllocationID(ctx context.Context, conn *ec2.EC2, natGatewayID, allocationID string) (*ec2.NatGatewayAddress, error) {
+	output, err := FindNATGatewayByID(ctx, conn, natGatewayID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output.NatGatewayAddresses {
+		if aws.StringValue(v.AllocationId) == allocationID {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{}
+}
+
+func FindNATGatewayAddressByNATGatewayIDAndPrivateIP(ctx context.Context, conn *ec2.EC2, natGatewayID, privateIP string) (*ec2.NatGatewayAddress, error) {
+	output, err := FindNATGatewayByID(ctx, conn, natGatewayID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output.NatGatewayAddresses {
+		if aws.StringValue(v.PrivateIp) == privateIP {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{}
+}
+
 func FindPlacementGroupByName(ctx context.Context, conn *ec2.EC2, name string) (*ec2.PlacementGroup, error) {
 	input := &ec2.DescribePlacementGroupsInput{
 		GroupNames: aws.StringSlice([]string{name}),
@@ -6878,6 +6978,97 @@ func FindSnapshotTierStatusBySnapshotID(ctx context.Context, conn *ec2.EC2, id s
 	return output, nil
 }
 
+func FindFastSnapshotRestores(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeFastSnapshotRestoresInput) ([]*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	var output []*ec2.DescribeFastSnapshotRestoreSuccessItem
+
+	err := conn.DescribeFastSnapshotRestoresPagesWithContext(ctx, input, func(page *ec2.DescribeFastSnapshotRestoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FastSnapshotRestores {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindFastSnapshotRestoresBySnapshotID(ctx context.Context, conn *ec2.EC2, snapshotID string) ([]*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	input := &ec2.DescribeFastSnapshotRestoresInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"snapshot-id": snapshotID,
+		}),
+	}
+
+	output, err := FindFastSnapshotRestores(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var fastSnapshotRestores []*ec2.DescribeFastSnapshotRestoreSuccessItem
+
+	for _, v := range output {
+		if aws.StringValue(v.State) == ec2.FastSnapshotRestoreStateCodeDisabled {
+			continue
+		}
+
+		fastSnapshotRestores = append(fastSnapshotRestores, v)
+	}
+
+	return fastSnapshotRestores, nil
+}
+
+func FindFastSnapshotRestoreByTwoPartKey(ctx context.Context, conn *ec2.EC2, snapshotID, availabilityZone string) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	input := &ec2.DescribeFastSnapshotRestoresInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"availability-zone": availabilityZone,
+			"snapshot-id":       snapshotID,
+		}),
+	}
+
+	output, err := FindFastSnapshotRestores(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	fastSnapshotRestore := output[0]
+
+	if state := aws.StringValue(fastSnapshotRestore.State); state == ec2.FastSnapshotRestoreStateCodeDisabled {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(fastSnapshotRestore.SnapshotId) != snapshotID || aws.StringValue(fastSnapshotRestore.AvailabilityZone) != availabilityZone {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return fastSnapshotRestore, nil
+}
+
 func FindNetworkPerformanceMetricSubscriptions(ctx context.Context, conn *ec2_sdkv2.Client, input *ec2_sdkv2.DescribeAwsNetworkPerformanceMetricSubscriptionsInput) ([]types.Subscription, error) {
 	var output []types.Subscription
 	paginator := ec2_sdkv2.NewDescribeAwsNetworkPerformanceMetricSubscriptionsPaginator(conn, input, func(o *ec2_sdkv2.DescribeAwsNetworkPerformanceMetricSubscriptionsPaginatorOptions) {
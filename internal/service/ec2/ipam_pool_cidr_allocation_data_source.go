@@ -0,0 +1,98 @@
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_vpc_ipam_pool_cidr_allocation")
+func DataSourceIPAMPoolCIDRAllocation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceIPAMPoolCIDRAllocationRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"cidr", "ipam_pool_allocation_id"},
+				ValidateFunc: validation.Any(
+					verify.ValidIPv4CIDRNetworkAddress,
+					verify.ValidIPv6CIDRNetworkAddress,
+				),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipam_pool_allocation_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"cidr", "ipam_pool_allocation_id"},
+			},
+			"ipam_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceIPAMPoolCIDRAllocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	poolID := d.Get("ipam_pool_id").(string)
+
+	var allocation *ec2.IpamPoolAllocation
+	var err error
+
+	if v, ok := d.GetOk("ipam_pool_allocation_id"); ok {
+		allocation, err = FindIPAMPoolAllocationByTwoPartKey(ctx, conn, v.(string), poolID)
+	} else {
+		allocation, err = FindIPAMPoolAllocationByPoolIDAndCIDR(ctx, conn, poolID, d.Get("cidr").(string))
+	}
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("IPAM Pool CIDR Allocation", err))
+	}
+
+	allocationID := aws.StringValue(allocation.IpamPoolAllocationId)
+	d.SetId(IPAMPoolCIDRAllocationCreateResourceID(allocationID, poolID))
+	d.Set("cidr", allocation.Cidr)
+	d.Set("description", allocation.Description)
+	d.Set("ipam_pool_allocation_id", allocationID)
+	d.Set("ipam_pool_id", poolID)
+	d.Set("resource_id", allocation.ResourceId)
+	d.Set("resource_owner", allocation.ResourceOwner)
+	d.Set("resource_type", allocation.ResourceType)
+
+	return diags
+}
@@ -0,0 +1,65 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIPAMPoolCIDRAllocationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_ipam_pool_cidr_allocation.test"
+	dataSourceNameByCIDR := "data.aws_vpc_ipam_pool_cidr_allocation.by_cidr"
+	dataSourceNameByID := "data.aws_vpc_ipam_pool_cidr_allocation.by_id"
+	cidr := "172.2.0.0/28"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMPoolCIDRAllocationDataSourceConfig_basic(cidr),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceNameByCIDR, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceNameByCIDR, "cidr", cidr),
+					resource.TestCheckResourceAttrPair(dataSourceNameByCIDR, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByCIDR, "ipam_pool_allocation_id", resourceName, "ipam_pool_allocation_id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByCIDR, "resource_id", resourceName, "resource_id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByCIDR, "resource_owner", resourceName, "resource_owner"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByCIDR, "resource_type", resourceName, "resource_type"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceNameByID, "cidr", cidr),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "resource_type", resourceName, "resource_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIPAMPoolCIDRAllocationDataSourceConfig_basic(cidr string) string {
+	return acctest.ConfigCompose(testAccIPAMPoolCIDRAllocationConfig_base, fmt.Sprintf(`
+resource "aws_vpc_ipam_pool_cidr_allocation" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = %[1]q
+  description  = "test"
+
+  depends_on = [
+    aws_vpc_ipam_pool_cidr.test
+  ]
+}
+
+data "aws_vpc_ipam_pool_cidr_allocation" "by_cidr" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = aws_vpc_ipam_pool_cidr_allocation.test.cidr
+}
+
+data "aws_vpc_ipam_pool_cidr_allocation" "by_id" {
+  ipam_pool_id            = aws_vpc_ipam_pool.test.id
+  ipam_pool_allocation_id = aws_vpc_ipam_pool_cidr_allocation.test.ipam_pool_allocation_id
+}
+`, cidr))
+}
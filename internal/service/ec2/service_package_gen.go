@@ -315,6 +315,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceIPAMPool,
 			TypeName: "aws_vpc_ipam_pool",
 		},
+		{
+			Factory:  DataSourceIPAMPoolCIDRAllocation,
+			TypeName: "aws_vpc_ipam_pool_cidr_allocation",
+		},
 		{
 			Factory:  DataSourceIPAMPoolCIDRs,
 			TypeName: "aws_vpc_ipam_pool_cidrs",
@@ -572,6 +576,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceTransitGatewayRouteTablePropagation,
 			TypeName: "aws_ec2_transit_gateway_route_table_propagation",
 		},
+		{
+			Factory:  ResourceTransitGatewayRouteTablePropagations,
+			TypeName: "aws_ec2_transit_gateway_route_table_propagations",
+		},
 		{
 			Factory:  ResourceTransitGatewayVPCAttachment,
 			TypeName: "aws_ec2_transit_gateway_vpc_attachment",
@@ -740,6 +748,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceVPCEndpointServiceAllowedPrincipal,
 			TypeName: "aws_vpc_endpoint_service_allowed_principal",
 		},
+		{
+			Factory:  ResourceVPCEndpointServicePrivateDNSVerification,
+			TypeName: "aws_vpc_endpoint_service_private_dns_verification",
+		},
 		{
 			Factory:  ResourceVPCEndpointSubnetAssociation,
 			TypeName: "aws_vpc_endpoint_subnet_association",
@@ -350,6 +350,38 @@ func StatusNATGatewayState(ctx context.Context, conn *ec2.EC2, id string) resour
 	}
 }
 
+func StatusNATGatewayAddressByNATGatewayIDAndAllocationID(ctx context.Context, conn *ec2.EC2, natGatewayID, allocationID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindNATGatewayAddressByNATGatewayIDAndAllocationID(ctx, conn, natGatewayID, allocationID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func StatusNATGatewayAddressByNATGatewayIDAndPrivateIP(ctx context.Context, conn *ec2.EC2, natGatewayID, privateIP string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindNATGatewayAddressByNATGatewayIDAndPrivateIP(ctx, conn, natGatewayID, privateIP)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
 const (
 	RouteStatusReady = "ready"
 )
@@ -1217,6 +1249,26 @@ func StatusVPCEndpointServiceStateDeleted(ctx context.Context, conn *ec2.EC2, id
 	}
 }
 
+func StatusVPCEndpointServicePrivateDNSNameConfiguration(ctx context.Context, conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindVPCEndpointServiceConfigurationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.PrivateDnsNameConfiguration == nil {
+			return nil, "", nil
+		}
+
+		return output.PrivateDnsNameConfiguration, aws.StringValue(output.PrivateDnsNameConfiguration.State), nil
+	}
+}
+
 const (
 	VPCEndpointRouteTableAssociationStatusReady = "ready"
 )
@@ -1285,6 +1337,22 @@ func StatusSnapshotStorageTier(ctx context.Context, conn *ec2.EC2, id string) re
 	}
 }
 
+func StatusFastSnapshotRestoreState(ctx context.Context, conn *ec2.EC2, snapshotID, availabilityZone string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFastSnapshotRestoreByTwoPartKey(ctx, conn, snapshotID, availabilityZone)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
 func StatusIPAMState(ctx context.Context, conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindIPAMByID(ctx, conn, id)
@@ -0,0 +1,162 @@
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_ec2_transit_gateway_route_table_propagations")
+func ResourceTransitGatewayRouteTablePropagations() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTransitGatewayRouteTablePropagationsCreate,
+		ReadWithoutTimeout:   resourceTransitGatewayRouteTablePropagationsRead,
+		UpdateWithoutTimeout: resourceTransitGatewayRouteTablePropagationsUpdate,
+		DeleteWithoutTimeout: resourceTransitGatewayRouteTablePropagationsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"transit_gateway_attachment_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+			"transit_gateway_route_table_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayRouteTablePropagationsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	transitGatewayRouteTableID := d.Get("transit_gateway_route_table_id").(string)
+
+	// Set the ID first so that propagations enabled before a partial failure remain tracked.
+	d.SetId(transitGatewayRouteTableID)
+
+	for _, transitGatewayAttachmentID := range flex.ExpandStringValueSet(d.Get("transit_gateway_attachment_ids").(*schema.Set)) {
+		if err := transitGatewayRouteTablePropagationUpdate(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID, true); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceTransitGatewayRouteTablePropagationsRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayRouteTablePropagationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	propagations, err := FindTransitGatewayRouteTablePropagations(ctx, conn, &ec2.GetTransitGatewayRouteTablePropagationsInput{
+		TransitGatewayRouteTableId: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Route Table (%s) not found, removing propagations from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Route Table (%s) propagations: %s", d.Id(), err)
+	}
+
+	// The resource is authoritative: propagations enabled out-of-band are surfaced
+	// and attachments that have disappeared out-of-band are dropped from state.
+	var transitGatewayAttachmentIDs []string
+
+	for transitGatewayAttachmentID := range enabledTransitGatewayRouteTablePropagations(propagations) {
+		transitGatewayAttachmentIDs = append(transitGatewayAttachmentIDs, transitGatewayAttachmentID)
+	}
+
+	d.Set("transit_gateway_attachment_ids", transitGatewayAttachmentIDs)
+	d.Set("transit_gateway_route_table_id", d.Id())
+
+	return diags
+}
+
+func resourceTransitGatewayRouteTablePropagationsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	if d.HasChange("transit_gateway_attachment_ids") {
+		o, n := d.GetChange("transit_gateway_attachment_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		for _, transitGatewayAttachmentID := range flex.ExpandStringValueSet(os.Difference(ns)) {
+			if err := disableTransitGatewayRouteTablePropagation(ctx, conn, d.Id(), transitGatewayAttachmentID); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
+		for _, transitGatewayAttachmentID := range flex.ExpandStringValueSet(ns.Difference(os)) {
+			if err := transitGatewayRouteTablePropagationUpdate(ctx, conn, d.Id(), transitGatewayAttachmentID, true); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceTransitGatewayRouteTablePropagationsRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayRouteTablePropagationsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Route Table Propagations: %s", d.Id())
+	for _, transitGatewayAttachmentID := range flex.ExpandStringValueSet(d.Get("transit_gateway_attachment_ids").(*schema.Set)) {
+		if err := disableTransitGatewayRouteTablePropagation(ctx, conn, d.Id(), transitGatewayAttachmentID); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return diags
+}
+
+// enabledTransitGatewayRouteTablePropagations returns the IDs of attachments whose propagation is enabled or enabling.
+func enabledTransitGatewayRouteTablePropagations(propagations []*ec2.TransitGatewayRouteTablePropagation) map[string]struct{} {
+	propagated := make(map[string]struct{})
+
+	for _, v := range propagations {
+		switch aws.StringValue(v.State) {
+		case ec2.TransitGatewayPropagationStateEnabled, ec2.TransitGatewayPropagationStateEnabling:
+			propagated[aws.StringValue(v.TransitGatewayAttachmentId)] = struct{}{}
+		}
+	}
+
+	return propagated
+}
+
+// disableTransitGatewayRouteTablePropagation disables propagation, ignoring route tables or attachments that no longer exist.
+func disableTransitGatewayRouteTablePropagation(ctx context.Context, conn *ec2.EC2, transitGatewayRouteTableID, transitGatewayAttachmentID string) error {
+	err := transitGatewayRouteTablePropagationUpdate(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID, false)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound, errCodeInvalidTransitGatewayAttachmentIDNotFound) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,264 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccTransitGatewayRouteTablePropagations_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_transit_gateway_route_table_propagations.test"
+	transitGatewayRouteTableResourceName := "aws_ec2_transit_gateway_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTablePropagationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTablePropagationsConfig_basic(rName, "aws_ec2_transit_gateway_vpc_attachment.test[0].id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTablePropagationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "id", transitGatewayRouteTableResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_attachment_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "transit_gateway_attachment_ids.*", "aws_ec2_transit_gateway_vpc_attachment.test.0", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", transitGatewayRouteTableResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransitGatewayRouteTablePropagationsConfig_basic(rName, "aws_ec2_transit_gateway_vpc_attachment.test[0].id, aws_ec2_transit_gateway_vpc_attachment.test[1].id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTablePropagationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_attachment_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "transit_gateway_attachment_ids.*", "aws_ec2_transit_gateway_vpc_attachment.test.0", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "transit_gateway_attachment_ids.*", "aws_ec2_transit_gateway_vpc_attachment.test.1", "id"),
+				),
+			},
+			{
+				Config: testAccTransitGatewayRouteTablePropagationsConfig_basic(rName, "aws_ec2_transit_gateway_vpc_attachment.test[1].id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTablePropagationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_attachment_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "transit_gateway_attachment_ids.*", "aws_ec2_transit_gateway_vpc_attachment.test.1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTablePropagations_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_transit_gateway_route_table_propagations.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTablePropagationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTablePropagationsConfig_basic(rName, "aws_ec2_transit_gateway_vpc_attachment.test[0].id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTablePropagationsExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceTransitGatewayRouteTablePropagations(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTablePropagations_outOfBand(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_transit_gateway_route_table_propagations.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTablePropagationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTablePropagationsConfig_basic(rName, "aws_ec2_transit_gateway_vpc_attachment.test[0].id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTablePropagationsExists(ctx, resourceName),
+					testAccCheckTransitGatewayRouteTablePropagationsEnableOutOfBand(ctx, resourceName, "aws_ec2_transit_gateway_vpc_attachment.test.1"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTransitGatewayRouteTablePropagationsConfig_basic(rName, "aws_ec2_transit_gateway_vpc_attachment.test[0].id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTablePropagationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_attachment_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "transit_gateway_attachment_ids.*", "aws_ec2_transit_gateway_vpc_attachment.test.0", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTransitGatewayRouteTablePropagationsEnableOutOfBand(ctx context.Context, n, attachmentResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		attachment, ok := s.RootModule().Resources[attachmentResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", attachmentResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		_, err := conn.EnableTransitGatewayRouteTablePropagationWithContext(ctx, &ec2.EnableTransitGatewayRouteTablePropagationInput{
+			TransitGatewayAttachmentId: aws.String(attachment.Primary.ID),
+			TransitGatewayRouteTableId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfec2.WaitTransitGatewayRouteTablePropagationCreated(ctx, conn, rs.Primary.ID, attachment.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckTransitGatewayRouteTablePropagationsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Route Table Propagations ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		for k, v := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "transit_gateway_attachment_ids.") || k == "transit_gateway_attachment_ids.#" {
+				continue
+			}
+
+			if _, err := tfec2.FindTransitGatewayRouteTablePropagationByTwoPartKey(ctx, conn, rs.Primary.ID, v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayRouteTablePropagationsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_transit_gateway_route_table_propagations" {
+				continue
+			}
+
+			output, err := tfec2.FindTransitGatewayRouteTablePropagations(ctx, conn, &ec2.GetTransitGatewayRouteTablePropagationsInput{
+				TransitGatewayRouteTableId: aws.String(rs.Primary.ID),
+			})
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range output {
+				if aws.StringValue(v.State) != ec2.TransitGatewayPropagationStateDisabled {
+					return fmt.Errorf("EC2 Transit Gateway Route Table Propagations %s still exist", rs.Primary.ID)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTransitGatewayRouteTablePropagationsConfig_basic(rName, attachmentIDs string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  count = 2
+
+  cidr_block = "10.${count.index}.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  cidr_block = "10.${count.index}.0.0/24"
+  vpc_id     = aws_vpc.test[count.index].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  count = 2
+
+  subnet_ids         = [aws_subnet.test[count.index].id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test[count.index].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table_propagations" "test" {
+  transit_gateway_attachment_ids = [%[2]s]
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+}
+`, rName, attachmentIDs)
+}
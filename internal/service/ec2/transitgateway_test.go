@@ -124,6 +124,11 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			"basic":      testAccTransitGatewayRouteTablePropagation_basic,
 			"disappears": testAccTransitGatewayRouteTablePropagation_disappears,
 		},
+		"RouteTablePropagations": {
+			"basic":      testAccTransitGatewayRouteTablePropagations_basic,
+			"disappears": testAccTransitGatewayRouteTablePropagations_disappears,
+			"outOfBand":  testAccTransitGatewayRouteTablePropagations_outOfBand,
+		},
 		"VpcAttachment": {
 			"basic":                testAccTransitGatewayVPCAttachment_basic,
 			"disappears":           testAccTransitGatewayVPCAttachment_disappears,
@@ -2,6 +2,8 @@ package ec2
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -37,11 +39,7 @@ func ResourceDefaultNetworkACL() *schema.Resource {
 		DeleteWithoutTimeout: resourceDefaultNetworkACLDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				d.Set("default_network_acl_id", d.Id())
-
-				return []*schema.ResourceData{d}, nil
-			},
+			StateContext: resourceDefaultNetworkACLImport,
 		},
 
 		// Keep in sync with aws_network_acl's schema with the following changes:
@@ -151,6 +149,35 @@ func resourceDefaultNetworkACLUpdate(ctx context.Context, d *schema.ResourceData
 	return append(diags, resourceNetworkACLRead(ctx, d, meta)...)
 }
 
+func resourceDefaultNetworkACLImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	// A network ACL ID can be used as-is, but only if it is the default network ACL of its VPC.
+	if !strings.HasPrefix(d.Id(), "vpc-") {
+		nacl, err := FindNetworkACLByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !aws.BoolValue(nacl.IsDefault) {
+			return nil, fmt.Errorf("EC2 Network ACL (%s) is not the default network ACL of VPC (%s)", d.Id(), aws.StringValue(nacl.VpcId))
+		}
+	} else {
+		nacl, err := FindVPCDefaultNetworkACL(ctx, conn, d.Id())
+
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(aws.StringValue(nacl.NetworkAclId))
+	}
+
+	d.Set("default_network_acl_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceDefaultNetworkACLDelete(_ context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	return sdkdiag.AppendWarningf(diags, "EC2 Default Network ACL (%s) not deleted, removing from state", d.Id())
 }
@@ -47,6 +47,48 @@ func TestAccVPCDefaultNetworkACL_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccDefaultNetworkACLImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCDefaultNetworkACL_Import_nonDefault(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.NetworkAcl
+	resourceName := "aws_default_network_acl.test"
+	naclResourceName := "aws_network_acl.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultNetworkACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCDefaultNetworkACLConfig_nonDefault(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDefaultNetworkACLExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[naclResourceName]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", naclResourceName)
+					}
+
+					return rs.Primary.ID, nil
+				},
+				ExpectError: regexp.MustCompile(`is not the default network ACL`),
+			},
 		},
 	})
 }
@@ -349,6 +391,18 @@ resource "aws_default_network_acl" "test" {
 `, rName)
 }
 
+func testAccVPCDefaultNetworkACLConfig_nonDefault(rName string) string {
+	return acctest.ConfigCompose(testAccVPCDefaultNetworkACLConfig_basic(rName), fmt.Sprintf(`
+resource "aws_network_acl" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccVPCDefaultNetworkACLConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -542,3 +596,14 @@ resource "aws_default_network_acl" "test" {
 }
 `, rName)
 }
+
+func testAccDefaultNetworkACLImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["vpc_id"], nil
+	}
+}
@@ -2,6 +2,7 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -272,6 +273,23 @@ func resourceDefaultRouteTableDelete(ctx context.Context, d *schema.ResourceData
 func resourceDefaultRouteTableImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
+	// A route table ID can be used as-is, but only if it is the main route table of its VPC.
+	if !strings.HasPrefix(d.Id(), "vpc-") {
+		routeTable, err := FindRouteTableByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range routeTable.Associations {
+			if aws.BoolValue(v.Main) {
+				return []*schema.ResourceData{d}, nil
+			}
+		}
+
+		return nil, fmt.Errorf("EC2 Route Table (%s) is not the main route table of VPC (%s)", d.Id(), aws.StringValue(routeTable.VpcId))
+	}
+
 	routeTable, err := FindMainRouteTableByVPCID(ctx, conn, d.Id())
 
 	if err != nil {
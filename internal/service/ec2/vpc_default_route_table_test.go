@@ -59,6 +59,47 @@ func TestAccVPCDefaultRouteTable_basic(t *testing.T) {
 				ImportStateIdFunc: testAccDefaultRouteTableImportStateIdFunc(resourceName),
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCDefaultRouteTable_Import_nonMain(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable ec2.RouteTable
+	resourceName := "aws_default_route_table.test"
+	rtResourceName := "aws_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCDefaultRouteTableConfig_nonMain(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, resourceName, &routeTable),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[rtResourceName]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", rtResourceName)
+					}
+
+					return rs.Primary.ID, nil
+				},
+				ExpectError: regexp.MustCompile(`is not the main route table`),
+			},
 		},
 	})
 }
@@ -589,6 +630,18 @@ resource "aws_default_route_table" "test" {
 `, rName)
 }
 
+func testAccVPCDefaultRouteTableConfig_nonMain(rName string) string {
+	return acctest.ConfigCompose(testAccVPCDefaultRouteTableConfig_basic(rName), fmt.Sprintf(`
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccVPCDefaultRouteTableConfig_ipv4InternetGateway(rName, destinationCidr string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -2,6 +2,8 @@ package ec2
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -22,7 +24,7 @@ func ResourceDefaultSecurityGroup() *schema.Resource {
 		DeleteWithoutTimeout: schema.NoopContext,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceDefaultSecurityGroupImport,
 		},
 
 		SchemaVersion: 1, // Keep in sync with aws_security_group's schema version.
@@ -125,3 +127,32 @@ func resourceDefaultSecurityGroupCreate(ctx context.Context, d *schema.ResourceD
 
 	return resourceSecurityGroupUpdate(ctx, d, meta)
 }
+
+func resourceDefaultSecurityGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	// A security group ID can be used as-is, but only if it is the default security group of its VPC.
+	if !strings.HasPrefix(d.Id(), "vpc-") {
+		sg, err := FindSecurityGroupByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return nil, err
+		}
+
+		if aws.StringValue(sg.GroupName) != DefaultSecurityGroupName {
+			return nil, fmt.Errorf("EC2 Security Group (%s) is not the default security group of VPC (%s)", d.Id(), aws.StringValue(sg.VpcId))
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+
+	sg, err := FindVPCDefaultSecurityGroup(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(aws.StringValue(sg.GroupId))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,111 @@
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_vpc_endpoint_service_private_dns_verification")
+func ResourceVPCEndpointServicePrivateDNSVerification() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVPCEndpointServicePrivateDNSVerificationCreate,
+		ReadWithoutTimeout:   resourceVPCEndpointServicePrivateDNSVerificationRead,
+		DeleteWithoutTimeout: resourceVPCEndpointServicePrivateDNSVerificationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"private_dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_for_verification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceVPCEndpointServicePrivateDNSVerificationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	serviceID := d.Get("service_id").(string)
+	input := &ec2.StartVpcEndpointServicePrivateDnsVerificationInput{
+		ServiceId: aws.String(serviceID),
+	}
+
+	log.Printf("[DEBUG] Starting EC2 VPC Endpoint Service private DNS verification: %s", input)
+	_, err := conn.StartVpcEndpointServicePrivateDnsVerificationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting EC2 VPC Endpoint Service (%s) private DNS verification: %s", serviceID, err)
+	}
+
+	d.SetId(serviceID)
+
+	if d.Get("wait_for_verification").(bool) {
+		if _, err := WaitVPCEndpointServicePrivateDNSNameVerified(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 VPC Endpoint Service (%s) private DNS name verification: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceVPCEndpointServicePrivateDNSVerificationRead(ctx, d, meta)...)
+}
+
+func resourceVPCEndpointServicePrivateDNSVerificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	svcCfg, err := FindVPCEndpointServiceConfigurationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 VPC Endpoint Service Private DNS Verification %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 VPC Endpoint Service (%s): %s", d.Id(), err)
+	}
+
+	d.Set("service_id", svcCfg.ServiceId)
+	d.Set("private_dns_name", svcCfg.PrivateDnsName)
+	if v := svcCfg.PrivateDnsNameConfiguration; v != nil {
+		d.Set("state", v.State)
+	} else {
+		d.Set("state", nil)
+	}
+
+	return diags
+}
+
+func resourceVPCEndpointServicePrivateDNSVerificationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Verification can't be undone, so this resource is only removed from state.
+	log.Printf("[WARN] EC2 VPC Endpoint Service Private DNS Verification (%s) removed from state only", d.Id())
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccVPCEndpointServicePrivateDNSVerification_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var svcCfg ec2.ServiceConfiguration
+	resourceName := "aws_vpc_endpoint_service_private_dns_verification.test"
+	serviceResourceName := "aws_vpc_endpoint_service.test"
+	rName := sdkacctest.RandomWithPrefix("tfacctest") // 32 character limit
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domainName := acctest.ACMCertificateRandomSubDomain(rootDomain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCEndpointServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointServicePrivateDNSVerificationConfig_basic(rName, rootDomain, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCEndpointServiceExists(ctx, serviceResourceName, &svcCfg),
+					resource.TestCheckResourceAttrPair(resourceName, "id", serviceResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_id", serviceResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_name", domainName),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.DnsNameStateVerified),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCEndpointServicePrivateDNSVerificationConfig_basic(rName, rootDomain, domainName string) string {
+	return acctest.ConfigCompose(testAccVPCEndpointServiceConfig_privateDNSName(rName, domainName), fmt.Sprintf(`
+data "aws_route53_zone" "test" {
+  name         = %[1]q
+  private_zone = false
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = data.aws_route53_zone.test.zone_id
+  name    = "${aws_vpc_endpoint_service.test.private_dns_name_configuration[0].name}.${aws_vpc_endpoint_service.test.private_dns_name}"
+  type    = aws_vpc_endpoint_service.test.private_dns_name_configuration[0].type
+  ttl     = 60
+  records = [aws_vpc_endpoint_service.test.private_dns_name_configuration[0].value]
+}
+
+resource "aws_vpc_endpoint_service_private_dns_verification" "test" {
+  service_id = aws_vpc_endpoint_service.test.id
+
+  depends_on = [aws_route53_record.test]
+}
+`, rootDomain))
+}
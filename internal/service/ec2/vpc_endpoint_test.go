@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -307,7 +308,7 @@ func TestAccVPCEndpoint_ignoreEquivalent(t *testing.T) {
 
 func TestAccVPCEndpoint_ipAddressType(t *testing.T) {
 	ctx := acctest.Context(t)
-	var endpoint ec2.VpcEndpoint
+	var endpoint1, endpoint2 ec2.VpcEndpoint
 	resourceName := "aws_vpc_endpoint.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -320,7 +321,7 @@ func TestAccVPCEndpoint_ipAddressType(t *testing.T) {
 			{
 				Config: testAccVPCEndpointConfig_ipAddressType(rName, "ipv4"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckVPCEndpointExists(ctx, resourceName, &endpoint),
+					testAccCheckVPCEndpointExists(ctx, resourceName, &endpoint1),
 					resource.TestCheckResourceAttr(resourceName, "dns_options.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "dns_options.0.dns_record_ip_type", "ipv4"),
 					resource.TestCheckResourceAttr(resourceName, "ip_address_type", "ipv4"),
@@ -335,10 +336,23 @@ func TestAccVPCEndpoint_ipAddressType(t *testing.T) {
 			{
 				Config: testAccVPCEndpointConfig_ipAddressType(rName, "dualstack"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckVPCEndpointExists(ctx, resourceName, &endpoint),
+					testAccCheckVPCEndpointExists(ctx, resourceName, &endpoint2),
+					testAccCheckVPCEndpointNotRecreated(&endpoint1, &endpoint2),
 					resource.TestCheckResourceAttr(resourceName, "dns_options.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "dns_options.0.dns_record_ip_type", "dualstack"),
 					resource.TestCheckResourceAttr(resourceName, "ip_address_type", "dualstack"),
+					resource.TestCheckResourceAttr(resourceName, "state", "available"),
+				),
+			},
+			{
+				Config: testAccVPCEndpointConfig_ipAddressType(rName, "ipv4"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCEndpointExists(ctx, resourceName, &endpoint2),
+					testAccCheckVPCEndpointNotRecreated(&endpoint1, &endpoint2),
+					resource.TestCheckResourceAttr(resourceName, "dns_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dns_options.0.dns_record_ip_type", "ipv4"),
+					resource.TestCheckResourceAttr(resourceName, "ip_address_type", "ipv4"),
+					resource.TestCheckResourceAttr(resourceName, "state", "available"),
 				),
 			},
 		},
@@ -527,6 +541,16 @@ func testAccCheckVPCEndpointExists(ctx context.Context, n string, v *ec2.VpcEndp
 	}
 }
 
+func testAccCheckVPCEndpointNotRecreated(before, after *ec2.VpcEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.VpcEndpointId), aws.StringValue(after.VpcEndpointId); before != after {
+			return fmt.Errorf("EC2 VPC Endpoint (%s/%s) recreated", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccVPCEndpointConfig_gatewayBasic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
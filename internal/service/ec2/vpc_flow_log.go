@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -156,10 +157,28 @@ func ResourceFlowLog() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceFlowLogCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceFlowLogCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Transit gateway flow logs only support a maximum aggregation interval of 60 seconds.
+	for _, key := range []string{"transit_gateway_attachment_id", "transit_gateway_id"} {
+		if diff.GetRawConfig().GetAttr(key).IsNull() {
+			continue
+		}
+
+		if v := diff.Get("max_aggregation_interval").(int); v != 60 {
+			return fmt.Errorf("max_aggregation_interval must be 60 when %s is set, got %d", key, v)
+		}
+	}
+
+	return nil
+}
+
 func resourceLogFlowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
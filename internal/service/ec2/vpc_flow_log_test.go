@@ -159,6 +159,24 @@ func TestAccVPCFlowLog_transitGatewayID(t *testing.T) {
 	})
 }
 
+func TestAccVPCFlowLog_TransitGatewayID_invalidMaxAggregationInterval(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowLogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCFlowLogConfig_transitGatewayIDMaxAggregationInterval(rName, 600),
+				ExpectError: regexp.MustCompile(`max_aggregation_interval must be 60 when transit_gateway_id is set`),
+			},
+		},
+	})
+}
+
 func TestAccVPCFlowLog_transitGatewayAttachmentID(t *testing.T) {
 	ctx := acctest.Context(t)
 	var flowLog ec2.FlowLog
@@ -1098,6 +1116,10 @@ resource "aws_flow_log" "test" {
 }
 
 func testAccVPCFlowLogConfig_transitGatewayID(rName string) string {
+	return testAccVPCFlowLogConfig_transitGatewayIDMaxAggregationInterval(rName, 60)
+}
+
+func testAccVPCFlowLogConfig_transitGatewayIDMaxAggregationInterval(rName string, maxAggregationInterval int) string {
 	return acctest.ConfigCompose(testAccFlowLogConfig_base(rName), fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
   tags = {
@@ -1137,14 +1159,14 @@ resource "aws_cloudwatch_log_group" "test" {
 resource "aws_flow_log" "test" {
   iam_role_arn             = aws_iam_role.test.arn
   log_group_name           = aws_cloudwatch_log_group.test.name
-  max_aggregation_interval = 60
+  max_aggregation_interval = %[2]d
   transit_gateway_id       = aws_ec2_transit_gateway.test.id
 
   tags = {
     Name = %[1]q
   }
 }
-`, rName))
+`, rName, maxAggregationInterval))
 }
 
 func testAccVPCFlowLogConfig_transitGatewayAttachmentID(rName string) string {
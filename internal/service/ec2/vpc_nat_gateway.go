@@ -2,16 +2,21 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -29,12 +34,22 @@ func ResourceNATGateway() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"allocation_id": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
 			},
+			"association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"connectivity_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -42,6 +57,14 @@ func ResourceNATGateway() *schema.Resource {
 				Default:      ec2.ConnectivityTypePublic,
 				ValidateFunc: validation.StringInSlice(ec2.ConnectivityType_Values(), false),
 			},
+			"failure_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"failure_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"network_interface_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -57,6 +80,26 @@ func ResourceNATGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"secondary_allocation_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"secondary_private_ip_address_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 7),
+			},
+			"secondary_private_ip_addresses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+			},
 			"subnet_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -66,7 +109,10 @@ func ResourceNATGateway() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceNATGatewayCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -92,19 +138,34 @@ func resourceNATGatewayCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.PrivateIpAddress = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("secondary_allocation_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecondaryAllocationIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("secondary_private_ip_address_count"); ok {
+		input.SecondaryPrivateIpAddressCount = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("secondary_private_ip_addresses"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecondaryPrivateIpAddresses = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("subnet_id"); ok {
 		input.SubnetId = aws.String(v.(string))
 	}
 
-	output, err := conn.CreateNatGatewayWithContext(ctx, input)
+	// The EIP or subnet may have been created in the same apply and not yet be visible.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return conn.CreateNatGatewayWithContext(ctx, input)
+	}, errCodeInvalidAllocationIDNotFound, errCodeInvalidSubnetIDNotFound)
 
 	if err != nil {
 		return diag.Errorf("creating EC2 NAT Gateway: %s", err)
 	}
 
-	d.SetId(aws.StringValue(output.NatGateway.NatGatewayId))
+	d.SetId(aws.StringValue(outputRaw.(*ec2.CreateNatGatewayOutput).NatGateway.NatGatewayId))
 
-	if _, err := WaitNATGatewayCreated(ctx, conn, d.Id()); err != nil {
+	if _, err := WaitNATGatewayCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("waiting for EC2 NAT Gateway (%s) create: %s", d.Id(), err)
 	}
 
@@ -128,12 +189,10 @@ func resourceNATGatewayRead(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.Errorf("reading EC2 NAT Gateway (%s): %s", d.Id(), err)
 	}
 
-	address := ng.NatGatewayAddresses[0]
-	d.Set("allocation_id", address.AllocationId)
 	d.Set("connectivity_type", ng.ConnectivityType)
-	d.Set("network_interface_id", address.NetworkInterfaceId)
-	d.Set("private_ip", address.PrivateIp)
-	d.Set("public_ip", address.PublicIp)
+	d.Set("failure_code", ng.FailureCode)
+	d.Set("failure_message", ng.FailureMessage)
+	setNATGatewayAddresses(d, ng.NatGatewayAddresses)
 	d.Set("subnet_id", ng.SubnetId)
 
 	tags := KeyValueTags(ctx, ng.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
@@ -153,6 +212,121 @@ func resourceNATGatewayRead(ctx context.Context, d *schema.ResourceData, meta in
 func resourceNATGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
+	switch d.Get("connectivity_type").(string) {
+	case ec2.ConnectivityTypePrivate:
+		if d.HasChanges("secondary_private_ip_addresses") {
+			o, n := d.GetChange("secondary_private_ip_addresses")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if add := ns.Difference(os); add.Len() > 0 {
+				input := &ec2.AssignPrivateNatGatewayAddressInput{
+					NatGatewayId:       aws.String(d.Id()),
+					PrivateIpAddresses: flex.ExpandStringSet(add),
+				}
+
+				if _, err := conn.AssignPrivateNatGatewayAddressWithContext(ctx, input); err != nil {
+					return diag.Errorf("assigning EC2 NAT Gateway (%s) private IP addresses: %s", d.Id(), err)
+				}
+
+				for _, privateIP := range flex.ExpandStringValueSet(add) {
+					if _, err := WaitNATGatewayAddressAssigned(ctx, conn, d.Id(), privateIP, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						return diag.Errorf("waiting for EC2 NAT Gateway (%s) private IP address (%s) assign: %s", d.Id(), privateIP, err)
+					}
+				}
+			}
+
+			if remove := os.Difference(ns); remove.Len() > 0 {
+				if err := unassignNATGatewayPrivateIPAddresses(ctx, conn, d.Id(), flex.ExpandStringValueSet(remove), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		} else if d.HasChanges("secondary_private_ip_address_count") {
+			o, n := d.GetChange("secondary_private_ip_address_count")
+			oCount, nCount := o.(int), n.(int)
+
+			if nCount > oCount {
+				input := &ec2.AssignPrivateNatGatewayAddressInput{
+					NatGatewayId:          aws.String(d.Id()),
+					PrivateIpAddressCount: aws.Int64(int64(nCount - oCount)),
+				}
+
+				output, err := conn.AssignPrivateNatGatewayAddressWithContext(ctx, input)
+
+				if err != nil {
+					return diag.Errorf("assigning EC2 NAT Gateway (%s) private IP addresses: %s", d.Id(), err)
+				}
+
+				for _, v := range output.NatGatewayAddresses {
+					privateIP := aws.StringValue(v.PrivateIp)
+
+					if _, err := WaitNATGatewayAddressAssigned(ctx, conn, d.Id(), privateIP, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						return diag.Errorf("waiting for EC2 NAT Gateway (%s) private IP address (%s) assign: %s", d.Id(), privateIP, err)
+					}
+				}
+			} else if nCount < oCount {
+				o, _ := d.GetChange("secondary_private_ip_addresses")
+				v := flex.ExpandStringValueSet(o.(*schema.Set))
+
+				if err := unassignNATGatewayPrivateIPAddresses(ctx, conn, d.Id(), v[:oCount-nCount], d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+	case ec2.ConnectivityTypePublic:
+		if d.HasChanges("secondary_allocation_ids") {
+			o, n := d.GetChange("secondary_allocation_ids")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if add := ns.Difference(os); add.Len() > 0 {
+				input := &ec2.AssociateNatGatewayAddressInput{
+					AllocationIds: flex.ExpandStringSet(add),
+					NatGatewayId:  aws.String(d.Id()),
+				}
+
+				if _, err := conn.AssociateNatGatewayAddressWithContext(ctx, input); err != nil {
+					return diag.Errorf("associating EC2 NAT Gateway (%s) allocation IDs: %s", d.Id(), err)
+				}
+
+				for _, allocationID := range flex.ExpandStringValueSet(add) {
+					if _, err := WaitNATGatewayAddressAssociated(ctx, conn, d.Id(), allocationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						return diag.Errorf("waiting for EC2 NAT Gateway (%s) allocation ID (%s) associate: %s", d.Id(), allocationID, err)
+					}
+				}
+			}
+
+			if remove := os.Difference(ns); remove.Len() > 0 {
+				ng, err := FindNATGatewayByID(ctx, conn, d.Id())
+
+				if err != nil {
+					return diag.Errorf("reading EC2 NAT Gateway (%s): %s", d.Id(), err)
+				}
+
+				associationIDs := make(map[string]string)
+				for _, v := range ng.NatGatewayAddresses {
+					associationIDs[aws.StringValue(v.AllocationId)] = aws.StringValue(v.AssociationId)
+				}
+
+				allocationIDs := flex.ExpandStringValueSet(remove)
+				input := &ec2.DisassociateNatGatewayAddressInput{
+					AssociationIds: aws.StringSlice(tfslices.ApplyToAll(allocationIDs, func(allocationID string) string {
+						return associationIDs[allocationID]
+					})),
+					NatGatewayId: aws.String(d.Id()),
+				}
+
+				if _, err := conn.DisassociateNatGatewayAddressWithContext(ctx, input); err != nil {
+					return diag.Errorf("disassociating EC2 NAT Gateway (%s) allocation IDs: %s", d.Id(), err)
+				}
+
+				for _, allocationID := range allocationIDs {
+					if _, err := WaitNATGatewayAddressDisassociated(ctx, conn, d.Id(), allocationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						return diag.Errorf("waiting for EC2 NAT Gateway (%s) allocation ID (%s) disassociate: %s", d.Id(), allocationID, err)
+					}
+				}
+			}
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -180,9 +354,103 @@ func resourceNATGatewayDelete(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("deleting EC2 NAT Gateway (%s): %s", d.Id(), err)
 	}
 
-	if _, err := WaitNATGatewayDeleted(ctx, conn, d.Id()); err != nil {
+	if _, err := WaitNATGatewayDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return diag.Errorf("waiting for EC2 NAT Gateway (%s) delete: %s", d.Id(), err)
 	}
 
 	return nil
 }
+
+func resourceNATGatewayCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	switch connectivityType := diff.Get("connectivity_type").(string); connectivityType {
+	case ec2.ConnectivityTypePrivate:
+		if v, ok := diff.GetOk("secondary_allocation_ids"); ok && v.(*schema.Set).Len() > 0 {
+			return fmt.Errorf(`secondary_allocation_ids is not supported with connectivity_type = "%s"`, connectivityType)
+		}
+
+		if diff.Id() != "" && diff.HasChange("secondary_private_ip_address_count") {
+			if v := diff.GetRawConfig().GetAttr("secondary_private_ip_addresses"); !v.IsKnown() || v.IsNull() {
+				if err := diff.SetNewComputed("secondary_private_ip_addresses"); err != nil {
+					return fmt.Errorf("setting secondary_private_ip_addresses to computed: %w", err)
+				}
+			}
+		}
+
+		if diff.Id() != "" && diff.HasChange("secondary_private_ip_addresses") {
+			if v := diff.GetRawConfig().GetAttr("secondary_private_ip_address_count"); !v.IsKnown() || v.IsNull() {
+				if err := diff.SetNewComputed("secondary_private_ip_address_count"); err != nil {
+					return fmt.Errorf("setting secondary_private_ip_address_count to computed: %w", err)
+				}
+			}
+		}
+	case ec2.ConnectivityTypePublic:
+		if v := diff.GetRawConfig().GetAttr("secondary_private_ip_address_count"); v.IsKnown() && !v.IsNull() {
+			return fmt.Errorf(`secondary_private_ip_address_count is not supported with connectivity_type = "%s"`, connectivityType)
+		}
+
+		if diff.Id() != "" && diff.HasChange("secondary_allocation_ids") {
+			if err := diff.SetNewComputed("secondary_private_ip_address_count"); err != nil {
+				return fmt.Errorf("setting secondary_private_ip_address_count to computed: %w", err)
+			}
+
+			if v := diff.GetRawConfig().GetAttr("secondary_private_ip_addresses"); !v.IsKnown() || v.IsNull() {
+				if err := diff.SetNewComputed("secondary_private_ip_addresses"); err != nil {
+					return fmt.Errorf("setting secondary_private_ip_addresses to computed: %w", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func unassignNATGatewayPrivateIPAddresses(ctx context.Context, conn *ec2.EC2, id string, privateIPs []string, timeout time.Duration) error {
+	input := &ec2.UnassignPrivateNatGatewayAddressInput{
+		NatGatewayId:       aws.String(id),
+		PrivateIpAddresses: aws.StringSlice(privateIPs),
+	}
+
+	if _, err := conn.UnassignPrivateNatGatewayAddressWithContext(ctx, input); err != nil {
+		return fmt.Errorf("unassigning EC2 NAT Gateway (%s) private IP addresses: %w", id, err)
+	}
+
+	for _, privateIP := range privateIPs {
+		if _, err := WaitNATGatewayAddressUnassigned(ctx, conn, id, privateIP, timeout); err != nil {
+			return fmt.Errorf("waiting for EC2 NAT Gateway (%s) private IP address (%s) unassign: %w", id, privateIP, err)
+		}
+	}
+
+	return nil
+}
+
+// setNATGatewayAddresses sets the primary and secondary address attributes from the NAT gateway's addresses.
+// A NAT gateway that has failed may have no addresses, in which case the primary address attributes are left unset.
+func setNATGatewayAddresses(d *schema.ResourceData, apiObjects []*ec2.NatGatewayAddress) {
+	var secondaryAllocationIDs, secondaryPrivateIPAddresses []string
+
+	for _, address := range apiObjects {
+		if address == nil {
+			continue
+		}
+
+		// Older NAT gateways may not report IsPrimary on their only address.
+		if isPrimary := aws.BoolValue(address.IsPrimary); isPrimary || len(apiObjects) == 1 {
+			d.Set("allocation_id", address.AllocationId)
+			d.Set("association_id", address.AssociationId)
+			d.Set("network_interface_id", address.NetworkInterfaceId)
+			d.Set("private_ip", address.PrivateIp)
+			d.Set("public_ip", address.PublicIp)
+		} else {
+			if allocationID := aws.StringValue(address.AllocationId); allocationID != "" {
+				secondaryAllocationIDs = append(secondaryAllocationIDs, allocationID)
+			}
+			if privateIP := aws.StringValue(address.PrivateIp); privateIP != "" {
+				secondaryPrivateIPAddresses = append(secondaryPrivateIPAddresses, privateIP)
+			}
+		}
+	}
+
+	d.Set("secondary_allocation_ids", secondaryAllocationIDs)
+	d.Set("secondary_private_ip_address_count", len(secondaryPrivateIPAddresses))
+	d.Set("secondary_private_ip_addresses", secondaryPrivateIPAddresses)
+}
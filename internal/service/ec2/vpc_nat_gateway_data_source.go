@@ -27,6 +27,10 @@ func DataSourceNATGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"connectivity_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -49,6 +53,20 @@ func DataSourceNATGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"secondary_allocation_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"secondary_private_ip_address_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"secondary_private_ip_addresses": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"state": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -112,16 +130,7 @@ func dataSourceNATGatewayRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("state", ngw.State)
 	d.Set("subnet_id", ngw.SubnetId)
 	d.Set("vpc_id", ngw.VpcId)
-
-	for _, address := range ngw.NatGatewayAddresses {
-		if aws.StringValue(address.AllocationId) != "" {
-			d.Set("allocation_id", address.AllocationId)
-			d.Set("network_interface_id", address.NetworkInterfaceId)
-			d.Set("private_ip", address.PrivateIp)
-			d.Set("public_ip", address.PublicIp)
-			break
-		}
-	}
+	setNATGatewayAddresses(d, ngw.NatGatewayAddresses)
 
 	if err := d.Set("tags", KeyValueTags(ctx, ngw.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return diag.Errorf("error setting tags: %s", err)
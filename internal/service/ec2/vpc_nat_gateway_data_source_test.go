@@ -43,6 +43,35 @@ func TestAccVPCNATGatewayDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccVPCNATGatewayDataSource_private(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_nat_gateway.test"
+	resourceName := "aws_nat_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNATGatewayDataSourceConfig_private(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allocation_id", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "connectivity_type", "private"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "network_interface_id", resourceName, "network_interface_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "private_ip", resourceName, "private_ip"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_ip", ""),
+					resource.TestCheckResourceAttrPair(dataSourceName, "secondary_private_ip_address_count", resourceName, "secondary_private_ip_address_count"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "secondary_private_ip_addresses.#", resourceName, "secondary_private_ip_addresses.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccVPCNATGatewayDataSourceConfig_basic(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -106,3 +135,24 @@ data "aws_nat_gateway" "test_by_tags" {
 }
 `, rName))
 }
+
+func testAccVPCNATGatewayDataSourceConfig_private(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_nat_gateway" "test" {
+  connectivity_type                  = "private"
+  subnet_id                          = aws_subnet.test[0].id
+  secondary_private_ip_address_count = 2
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_nat_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+  state  = "available"
+
+  depends_on = [aws_nat_gateway.test]
+}
+`, rName))
+}
@@ -3,11 +3,14 @@ package ec2_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -15,6 +18,90 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestSetNATGatewayAddresses(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                                   string
+		NATGateway                             *ec2.NatGateway
+		ExpectedAllocationID                   string
+		ExpectedPrivateIP                      string
+		ExpectedSecondaryPrivateIPAddressCount int
+	}{
+		{
+			Name: "no addresses",
+			NATGateway: &ec2.NatGateway{
+				FailureCode:    aws.String("InvalidAllocationID.NotFound"),
+				FailureMessage: aws.String("Elastic IP address [eipalloc-0123456789abcdef0] could not be associated with this NAT gateway"),
+				NatGatewayId:   aws.String("nat-0123456789abcdef0"),
+				State:          aws.String(ec2.NatGatewayStateFailed),
+			},
+		},
+		{
+			Name: "primary address only",
+			NATGateway: &ec2.NatGateway{
+				NatGatewayAddresses: []*ec2.NatGatewayAddress{
+					{
+						AllocationId: aws.String("eipalloc-0123456789abcdef0"),
+						PrivateIp:    aws.String("10.0.0.8"),
+					},
+				},
+				NatGatewayId: aws.String("nat-0123456789abcdef0"),
+				State:        aws.String(ec2.NatGatewayStateAvailable),
+			},
+			ExpectedAllocationID: "eipalloc-0123456789abcdef0",
+			ExpectedPrivateIP:    "10.0.0.8",
+		},
+		{
+			Name: "primary and secondary addresses",
+			NATGateway: &ec2.NatGateway{
+				NatGatewayAddresses: []*ec2.NatGatewayAddress{
+					{
+						IsPrimary: aws.Bool(false),
+						PrivateIp: aws.String("10.0.0.9"),
+					},
+					{
+						IsPrimary: aws.Bool(true),
+						PrivateIp: aws.String("10.0.0.8"),
+					},
+					{
+						IsPrimary: aws.Bool(false),
+						PrivateIp: aws.String("10.0.0.10"),
+					},
+				},
+				NatGatewayId: aws.String("nat-0123456789abcdef0"),
+				State:        aws.String(ec2.NatGatewayStateAvailable),
+			},
+			ExpectedPrivateIP:                      "10.0.0.8",
+			ExpectedSecondaryPrivateIPAddressCount: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, tfec2.ResourceNATGateway().Schema, map[string]interface{}{})
+
+			tfec2.SetNATGatewayAddresses(d, testCase.NATGateway.NatGatewayAddresses)
+
+			if got, want := d.Get("allocation_id").(string), testCase.ExpectedAllocationID; got != want {
+				t.Errorf("allocation_id = %q, want %q", got, want)
+			}
+
+			if got, want := d.Get("private_ip").(string), testCase.ExpectedPrivateIP; got != want {
+				t.Errorf("private_ip = %q, want %q", got, want)
+			}
+
+			if got, want := d.Get("secondary_private_ip_address_count").(int), testCase.ExpectedSecondaryPrivateIPAddressCount; got != want {
+				t.Errorf("secondary_private_ip_address_count = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
 func TestAccVPCNATGateway_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var natGateway ec2.NatGateway
@@ -33,6 +120,8 @@ func TestAccVPCNATGateway_basic(t *testing.T) {
 					testAccCheckNATGatewayExists(ctx, resourceName, &natGateway),
 					resource.TestCheckResourceAttrSet(resourceName, "allocation_id"),
 					resource.TestCheckResourceAttr(resourceName, "connectivity_type", "public"),
+					resource.TestCheckResourceAttr(resourceName, "failure_code", ""),
+					resource.TestCheckResourceAttr(resourceName, "failure_message", ""),
 					resource.TestCheckResourceAttrSet(resourceName, "network_interface_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "private_ip"),
 					resource.TestCheckResourceAttrSet(resourceName, "public_ip"),
@@ -138,6 +227,137 @@ func TestAccVPCNATGateway_privateIP(t *testing.T) {
 	})
 }
 
+func TestAccVPCNATGateway_secondaryAllocationIDs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var natGateway ec2.NatGateway
+	resourceName := "aws_nat_gateway.test"
+	eipResourceName := "aws_eip.secondary"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNATGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNATGatewayConfig_secondaryAllocationIDs(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNATGatewayExists(ctx, resourceName, &natGateway),
+					resource.TestCheckResourceAttr(resourceName, "secondary_allocation_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "secondary_allocation_ids.*", eipResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_address_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_addresses.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCNATGatewayConfig_secondaryAllocationIDs(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNATGatewayExists(ctx, resourceName, &natGateway),
+					resource.TestCheckResourceAttr(resourceName, "secondary_allocation_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_address_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_addresses.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCNATGateway_secondaryPrivateIPAddressCount(t *testing.T) {
+	ctx := acctest.Context(t)
+	var natGateway1, natGateway2, natGateway3 ec2.NatGateway
+	resourceName := "aws_nat_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNATGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNATGatewayConfig_secondaryPrivateIPAddressCount(rName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNATGatewayExists(ctx, resourceName, &natGateway1),
+					resource.TestCheckResourceAttr(resourceName, "connectivity_type", "private"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_address_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_addresses.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCNATGatewayConfig_secondaryPrivateIPAddressCount(rName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNATGatewayExists(ctx, resourceName, &natGateway2),
+					testAccCheckNATGatewayNotRecreated(&natGateway1, &natGateway2),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_address_count", "5"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_addresses.#", "5"),
+				),
+			},
+			{
+				Config: testAccVPCNATGatewayConfig_secondaryPrivateIPAddressCount(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNATGatewayExists(ctx, resourceName, &natGateway3),
+					testAccCheckNATGatewayNotRecreated(&natGateway2, &natGateway3),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_address_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_addresses.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCNATGateway_secondaryPrivateIPAddresses(t *testing.T) {
+	ctx := acctest.Context(t)
+	var natGateway1, natGateway2 ec2.NatGateway
+	resourceName := "aws_nat_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNATGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNATGatewayConfig_secondaryPrivateIPAddresses(rName, []string{"10.0.0.10", "10.0.0.11"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNATGatewayExists(ctx, resourceName, &natGateway1),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_address_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_addresses.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "secondary_private_ip_addresses.*", "10.0.0.10"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "secondary_private_ip_addresses.*", "10.0.0.11"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCNATGatewayConfig_secondaryPrivateIPAddresses(rName, []string{"10.0.0.11", "10.0.0.12"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNATGatewayExists(ctx, resourceName, &natGateway2),
+					testAccCheckNATGatewayNotRecreated(&natGateway1, &natGateway2),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_address_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_addresses.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "secondary_private_ip_addresses.*", "10.0.0.11"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "secondary_private_ip_addresses.*", "10.0.0.12"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCNATGateway_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var natGateway ec2.NatGateway
@@ -235,6 +455,16 @@ func testAccCheckNATGatewayExists(ctx context.Context, n string, v *ec2.NatGatew
 	}
 }
 
+func testAccCheckNATGatewayNotRecreated(before, after *ec2.NatGateway) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.NatGatewayId), aws.StringValue(after.NatGatewayId); before != after {
+			return fmt.Errorf("EC2 NAT Gateway (%s/%s) recreated", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccNATGatewayConfig_base(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -351,3 +581,55 @@ resource "aws_nat_gateway" "test" {
 }
 `, tagKey1, tagValue1, tagKey2, tagValue2))
 }
+
+func testAccVPCNATGatewayConfig_secondaryAllocationIDs(rName string, hasSecondary bool) string {
+	return acctest.ConfigCompose(testAccNATGatewayConfig_base(rName), fmt.Sprintf(`
+resource "aws_eip" "secondary" {
+  vpc = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_nat_gateway" "test" {
+  allocation_id            = aws_eip.test.id
+  subnet_id                = aws_subnet.public.id
+  secondary_allocation_ids = %[2]t ? [aws_eip.secondary.id] : []
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_internet_gateway.test]
+}
+`, rName, hasSecondary))
+}
+
+func testAccVPCNATGatewayConfig_secondaryPrivateIPAddressCount(rName string, secondaryPrivateIpAddressCount int) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_nat_gateway" "test" {
+  connectivity_type                  = "private"
+  subnet_id                          = aws_subnet.test[0].id
+  secondary_private_ip_address_count = %[2]d
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, secondaryPrivateIpAddressCount))
+}
+
+func testAccVPCNATGatewayConfig_secondaryPrivateIPAddresses(rName string, secondaryPrivateIPAddresses []string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_nat_gateway" "test" {
+  connectivity_type              = "private"
+  subnet_id                      = aws_subnet.test[0].id
+  secondary_private_ip_addresses = ["%[2]s"]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, strings.Join(secondaryPrivateIPAddresses, `", "`)))
+}
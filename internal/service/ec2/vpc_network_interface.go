@@ -472,7 +472,13 @@ func resourceNetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData,
 	if v, ok := d.GetOk("attachment"); ok && v.(*schema.Set).Len() > 0 {
 		attachment := v.(*schema.Set).List()[0].(map[string]interface{})
 
-		_, err := attachNetworkInterface(ctx, conn, d.Id(), attachment["instance"].(string), attachment["device_index"].(int), networkInterfaceAttachedTimeout)
+		input := &ec2.AttachNetworkInterfaceInput{
+			DeviceIndex:        aws.Int64(int64(attachment["device_index"].(int))),
+			InstanceId:         aws.String(attachment["instance"].(string)),
+			NetworkInterfaceId: aws.String(d.Id()),
+		}
+
+		_, err := attachNetworkInterface(ctx, conn, input, networkInterfaceAttachedTimeout)
 
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -590,7 +596,13 @@ func resourceNetworkInterfaceUpdate(ctx context.Context, d *schema.ResourceData,
 		if na != nil && na.(*schema.Set).Len() > 0 {
 			attachment := na.(*schema.Set).List()[0].(map[string]interface{})
 
-			_, err := attachNetworkInterface(ctx, conn, d.Id(), attachment["instance"].(string), attachment["device_index"].(int), networkInterfaceAttachedTimeout)
+			input := &ec2.AttachNetworkInterfaceInput{
+				DeviceIndex:        aws.Int64(int64(attachment["device_index"].(int))),
+				InstanceId:         aws.String(attachment["instance"].(string)),
+				NetworkInterfaceId: aws.String(d.Id()),
+			}
+
+			_, err := attachNetworkInterface(ctx, conn, input, networkInterfaceAttachedTimeout)
 
 			if err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
@@ -1070,12 +1082,8 @@ func resourceNetworkInterfaceDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-func attachNetworkInterface(ctx context.Context, conn *ec2.EC2, networkInterfaceID, instanceID string, deviceIndex int, timeout time.Duration) (string, error) {
-	input := &ec2.AttachNetworkInterfaceInput{
-		DeviceIndex:        aws.Int64(int64(deviceIndex)),
-		InstanceId:         aws.String(instanceID),
-		NetworkInterfaceId: aws.String(networkInterfaceID),
-	}
+func attachNetworkInterface(ctx context.Context, conn *ec2.EC2, input *ec2.AttachNetworkInterfaceInput, timeout time.Duration) (string, error) {
+	networkInterfaceID, instanceID := aws.StringValue(input.NetworkInterfaceId), aws.StringValue(input.InstanceId)
 
 	output, err := conn.AttachNetworkInterfaceWithContext(ctx, input)
 
@@ -2,8 +2,11 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -16,6 +19,7 @@ func ResourceNetworkInterfaceAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceNetworkInterfaceAttachmentCreate,
 		ReadWithoutTimeout:   resourceNetworkInterfaceAttachmentRead,
+		UpdateWithoutTimeout: resourceNetworkInterfaceAttachmentUpdate,
 		DeleteWithoutTimeout: resourceNetworkInterfaceAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -31,6 +35,7 @@ func ResourceNetworkInterfaceAttachment() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"ena_srd_specification": enaSrdSpecificationSchema(),
 			"instance_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -53,12 +58,17 @@ func resourceNetworkInterfaceAttachmentCreate(ctx context.Context, d *schema.Res
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
-	attachmentID, err := attachNetworkInterface(ctx, conn,
-		d.Get("network_interface_id").(string),
-		d.Get("instance_id").(string),
-		d.Get("device_index").(int),
-		networkInterfaceAttachedTimeout,
-	)
+	input := &ec2.AttachNetworkInterfaceInput{
+		DeviceIndex:        aws.Int64(int64(d.Get("device_index").(int))),
+		InstanceId:         aws.String(d.Get("instance_id").(string)),
+		NetworkInterfaceId: aws.String(d.Get("network_interface_id").(string)),
+	}
+
+	if v, ok := d.GetOk("ena_srd_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EnaSrdSpecification = expandEnaSrdSpecification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	attachmentID, err := attachNetworkInterface(ctx, conn, input, networkInterfaceAttachedTimeout)
 
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
@@ -90,12 +100,28 @@ func resourceNetworkInterfaceAttachmentRead(ctx context.Context, d *schema.Resou
 	d.Set("network_interface_id", network_interface.NetworkInterfaceId)
 	d.Set("attachment_id", network_interface.Attachment.AttachmentId)
 	d.Set("device_index", network_interface.Attachment.DeviceIndex)
+	if err := d.Set("ena_srd_specification", flattenAttachmentEnaSrdSpecificationState(network_interface.Attachment.EnaSrdSpecification, d.Get("ena_srd_specification").([]interface{}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ena_srd_specification: %s", err)
+	}
 	d.Set("instance_id", network_interface.Attachment.InstanceId)
 	d.Set("status", network_interface.Attachment.Status)
 
 	return diags
 }
 
+func resourceNetworkInterfaceAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	if d.HasChange("ena_srd_specification") {
+		if err := modifyNetworkInterfaceEnaSrdSpecification(ctx, conn, d.Get("network_interface_id").(string), d.Get("ena_srd_specification").([]interface{})); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceNetworkInterfaceAttachmentRead(ctx, d, meta)...)
+}
+
 func resourceNetworkInterfaceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
@@ -105,3 +131,136 @@ func resourceNetworkInterfaceAttachmentDelete(ctx context.Context, d *schema.Res
 	}
 	return diags
 }
+
+func enaSrdSpecificationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ena_srd_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"ena_srd_udp_specification": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"ena_srd_udp_enabled": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func modifyNetworkInterfaceEnaSrdSpecification(ctx context.Context, conn *ec2.EC2, networkInterfaceID string, tfList []interface{}) error {
+	// Removing the configuration block disables ENA Express.
+	enaSrdSpecification := &ec2.EnaSrdSpecification{
+		EnaSrdEnabled: aws.Bool(false),
+	}
+
+	if len(tfList) > 0 && tfList[0] != nil {
+		enaSrdSpecification = expandEnaSrdSpecification(tfList[0].(map[string]interface{}))
+	}
+
+	input := &ec2.ModifyNetworkInterfaceAttributeInput{
+		EnaSrdSpecification: enaSrdSpecification,
+		NetworkInterfaceId:  aws.String(networkInterfaceID),
+	}
+
+	log.Printf("[DEBUG] Modifying EC2 Network Interface ENA SRD specification: %s", input)
+	if _, err := conn.ModifyNetworkInterfaceAttributeWithContext(ctx, input); err != nil {
+		return fmt.Errorf("modifying EC2 Network Interface (%s) ENA SRD specification: %w", networkInterfaceID, err)
+	}
+
+	return nil
+}
+
+func expandEnaSrdSpecification(tfMap map[string]interface{}) *ec2.EnaSrdSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.EnaSrdSpecification{}
+
+	if v, ok := tfMap["ena_srd_enabled"].(bool); ok {
+		apiObject.EnaSrdEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["ena_srd_udp_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EnaSrdUdpSpecification = expandEnaSrdUDPSpecification(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandEnaSrdUDPSpecification(tfMap map[string]interface{}) *ec2.EnaSrdUdpSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.EnaSrdUdpSpecification{}
+
+	if v, ok := tfMap["ena_srd_udp_enabled"].(bool); ok {
+		apiObject.EnaSrdUdpEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+// flattenAttachmentEnaSrdSpecificationState returns the ENA SRD specification to store in state.
+// A disabled specification is only kept if the configuration block is present, so that removing the block doesn't cause a diff.
+func flattenAttachmentEnaSrdSpecificationState(apiObject *ec2.AttachmentEnaSrdSpecification, tfList []interface{}) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	disabled := !aws.BoolValue(apiObject.EnaSrdEnabled) && (apiObject.EnaSrdUdpSpecification == nil || !aws.BoolValue(apiObject.EnaSrdUdpSpecification.EnaSrdUdpEnabled))
+
+	if disabled && len(tfList) == 0 {
+		return nil
+	}
+
+	return []interface{}{flattenAttachmentEnaSrdSpecification(apiObject)}
+}
+
+func flattenAttachmentEnaSrdSpecification(apiObject *ec2.AttachmentEnaSrdSpecification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EnaSrdEnabled; v != nil {
+		tfMap["ena_srd_enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.EnaSrdUdpSpecification; v != nil {
+		tfMap["ena_srd_udp_specification"] = []interface{}{flattenAttachmentEnaSrdUDPSpecification(v)}
+	}
+
+	return tfMap
+}
+
+func flattenAttachmentEnaSrdUDPSpecification(apiObject *ec2.AttachmentEnaSrdUdpSpecification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EnaSrdUdpEnabled; v != nil {
+		tfMap["ena_srd_udp_enabled"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
@@ -42,10 +44,78 @@ func TestAccVPCNetworkInterfaceAttachment_basic(t *testing.T) {
 	})
 }
 
-func testAccVPCNetworkInterfaceAttachmentConfig_basic(rName string) string {
+func TestAccVPCNetworkInterfaceAttachment_enaSrdSpecification(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf ec2.NetworkInterface
+	resourceName := "aws_network_interface_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckENIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInterfaceAttachmentConfig_enaSrdSpecification(rName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, "aws_network_interface.test", &conf),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_udp_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_udp_specification.0.ena_srd_udp_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCNetworkInterfaceAttachmentConfig_enaSrdSpecification(rName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, "aws_network_interface.test", &conf),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_udp_specification.0.ena_srd_udp_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccVPCNetworkInterfaceAttachmentConfig_enaSrdSpecification(rName, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, "aws_network_interface.test", &conf),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccVPCNetworkInterfaceAttachmentConfig_enaSrdSpecification(rName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, "aws_network_interface.test", &conf),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccVPCNetworkInterfaceAttachmentConfig_enaSrdSpecificationRemoved(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, "aws_network_interface.test", &conf),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.#", "0"),
+					func(s *terraform.State) error {
+						if v := conf.Attachment.EnaSrdSpecification; v != nil && aws.BoolValue(v.EnaSrdEnabled) {
+							return fmt.Errorf("ENA Express still enabled on EC2 Network Interface (%s)", aws.StringValue(conf.NetworkInterfaceId))
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCNetworkInterfaceAttachmentConfig_base(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
-		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro"),
 		acctest.ConfigAvailableAZsNoOptIn(),
 		fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -87,7 +157,69 @@ resource "aws_network_interface" "test" {
     Name = %[1]q
   }
 }
+`, rName))
+}
+
+func testAccVPCNetworkInterfaceAttachmentConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccVPCNetworkInterfaceAttachmentConfig_base(rName),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id     = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface_attachment" "test" {
+  device_index         = 1
+  instance_id          = aws_instance.test.id
+  network_interface_id = aws_network_interface.test.id
+}
+`, rName))
+}
 
+func testAccVPCNetworkInterfaceAttachmentConfig_enaSrdSpecification(rName string, enaSrdEnabled, enaSrdUDPEnabled bool) string {
+	return acctest.ConfigCompose(
+		testAccVPCNetworkInterfaceAttachmentConfig_base(rName),
+		// ENA Express is only supported on a subset of the largest instance sizes.
+		acctest.AvailableEC2InstanceTypeForRegion("c6in.32xlarge", "m6i.32xlarge"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id     = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface_attachment" "test" {
+  device_index         = 1
+  instance_id          = aws_instance.test.id
+  network_interface_id = aws_network_interface.test.id
+
+  ena_srd_specification {
+    ena_srd_enabled = %[2]t
+
+    ena_srd_udp_specification {
+      ena_srd_udp_enabled = %[3]t
+    }
+  }
+}
+`, rName, enaSrdEnabled, enaSrdUDPEnabled))
+}
+
+func testAccVPCNetworkInterfaceAttachmentConfig_enaSrdSpecificationRemoved(rName string) string {
+	return acctest.ConfigCompose(
+		testAccVPCNetworkInterfaceAttachmentConfig_base(rName),
+		acctest.AvailableEC2InstanceTypeForRegion("c6in.32xlarge", "m6i.32xlarge"),
+		fmt.Sprintf(`
 resource "aws_instance" "test" {
   ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
   instance_type = data.aws_ec2_instance_type_offering.available.instance_type
@@ -36,6 +36,8 @@ func TestAccVPCNetworkInterfaceDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(datasourceName, "vpc_id"),
 					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
 					resource.TestCheckResourceAttrPair(datasourceName, "owner_id", resourceName, "owner_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "source_dest_check", resourceName, "source_dest_check"),
+					resource.TestCheckResourceAttr(datasourceName, "ipv6_prefixes.#", "0"),
 				),
 			},
 		},
@@ -265,6 +265,14 @@ func resourceRouteCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "waiting for Route in Route Table (%s) with destination (%s) to become available: %s", routeTableID, destination, err)
 	}
 
+	if targetAttributeKey == "nat_gateway_id" {
+		if warning, err := routePrivateNATGatewayWarning(ctx, conn, target, destination); err != nil {
+			log.Printf("[WARN] %s", err)
+		} else if warning != "" {
+			diags = sdkdiag.AppendWarningf(diags, "%s", warning)
+		}
+	}
+
 	return append(diags, resourceRouteRead(ctx, d, meta)...)
 }
 
@@ -409,6 +417,14 @@ func resourceRouteUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "waiting for Route in Route Table (%s) with destination (%s) to become available: %s", routeTableID, destination, err)
 	}
 
+	if targetAttributeKey == "nat_gateway_id" {
+		if warning, err := routePrivateNATGatewayWarning(ctx, conn, target, destination); err != nil {
+			log.Printf("[WARN] %s", err)
+		} else if warning != "" {
+			diags = sdkdiag.AppendWarningf(diags, "%s", warning)
+		}
+	}
+
 	return append(diags, resourceRouteRead(ctx, d, meta)...)
 }
 
@@ -517,3 +533,27 @@ func routeTargetAttribute(d *schema.ResourceData) (string, string, error) {
 
 	return "", "", fmt.Errorf("route target attribute not specified")
 }
+
+// routePrivateNATGatewayWarning returns a warning message if the route sends all IPv4 traffic to a NAT gateway with private connectivity.
+// Traffic routed this way cannot reach the internet.
+func routePrivateNATGatewayWarning(ctx context.Context, conn *ec2.EC2, natGatewayID, destination string) (string, error) {
+	if destination != "0.0.0.0/0" {
+		return "", nil
+	}
+
+	ng, err := FindNATGatewayByID(ctx, conn, natGatewayID)
+
+	if tfresource.NotFound(err) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("reading EC2 NAT Gateway (%s): %w", natGatewayID, err)
+	}
+
+	if connectivityType := aws.StringValue(ng.ConnectivityType); connectivityType == ec2.ConnectivityTypePrivate {
+		return fmt.Sprintf("NAT Gateway (%s) has connectivity_type = %q and cannot route traffic for destination (%s) to the internet", natGatewayID, connectivityType, destination), nil
+	}
+
+	return "", nil
+}
@@ -761,6 +761,64 @@ func TestAccVPCRoute_ipv4ToNatGateway(t *testing.T) {
 	})
 }
 
+func TestAccVPCRoute_ipv4ToPrivateNatGateway(t *testing.T) {
+	ctx := acctest.Context(t)
+	var route ec2.Route
+	resourceName := "aws_route.test"
+	ngwResourceName := "aws_nat_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	destinationCidr := "0.0.0.0/0"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteConfig_ipv4PrivateNATGateway(rName, destinationCidr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteExists(ctx, resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", destinationCidr),
+					resource.TestCheckResourceAttrPair(resourceName, "nat_gateway_id", ngwResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.RouteStateActive),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCRoute_ipv4ToPrivateNatGatewayDefaultRoute(t *testing.T) {
+	ctx := acctest.Context(t)
+	var route ec2.Route
+	resourceName := "aws_route.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteConfig_ipv4PrivateNATGateway(rName, "10.2.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteExists(ctx, resourceName, &route),
+				),
+			},
+			{
+				// Only a warning is returned after apply.
+				Config: testAccVPCRouteConfig_ipv4PrivateNATGateway(rName, "0.0.0.0/0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteExists(ctx, resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.RouteStateActive),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCRoute_ipv6ToNatGateway(t *testing.T) {
 	ctx := acctest.Context(t)
 	var route ec2.Route
@@ -3124,6 +3182,50 @@ resource "aws_route" "test" {
 `, rName, destinationCidr)
 }
 
+func testAccVPCRouteConfig_ipv4PrivateNATGateway(rName, destinationCidr string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  cidr_block = "10.1.1.0/24"
+  vpc_id     = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_nat_gateway" "test" {
+  connectivity_type = "private"
+  subnet_id         = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route" "test" {
+  route_table_id         = aws_route_table.test.id
+  destination_cidr_block = %[2]q
+  nat_gateway_id         = aws_nat_gateway.test.id
+}
+`, rName, destinationCidr)
+}
+
 func testAccVPCRouteConfig_ipv6NATGateway(rName, destinationCidr string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
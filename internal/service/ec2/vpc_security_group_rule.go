@@ -297,7 +297,7 @@ func resourceSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("description", description)
 	d.Set("type", ruleType)
 
-	if strings.Contains(d.Id(), securityGroupRuleIDSeparator) {
+	if strings.Contains(d.Id(), securityGroupRuleIDSeparator) || strings.HasPrefix(d.Id(), securityGroupRuleIDPrefix) {
 		// import so fix the id
 		id := SecurityGroupRuleCreateID(securityGroupID, ruleType, ipPermission)
 		d.SetId(id)
@@ -418,7 +418,12 @@ func resourceSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData
 	return nil
 }
 
-func resourceSecurityGroupRuleImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceSecurityGroupRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// example: sgr-02108b27edd666983
+	if strings.HasPrefix(d.Id(), securityGroupRuleIDPrefix) {
+		return resourceSecurityGroupRuleImportByRuleID(ctx, d, meta)
+	}
+
 	invalidIDError := func(msg string) error {
 		return fmt.Errorf("unexpected format for ID (%q), expected SECURITYGROUPID_TYPE_PROTOCOL_FROMPORT_TOPORT_SOURCE[_SOURCE]*: %s", d.Id(), msg)
 	}
@@ -515,6 +520,47 @@ func resourceSecurityGroupRuleImport(_ context.Context, d *schema.ResourceData,
 	return []*schema.ResourceData{d}, nil
 }
 
+func resourceSecurityGroupRuleImportByRuleID(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	rule, err := FindSecurityGroupRuleByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Security Group Rule (%s): %w", d.Id(), err)
+	}
+
+	securityGroupID := aws.StringValue(rule.GroupId)
+	ruleType := securityGroupRuleTypeIngress
+	if aws.BoolValue(rule.IsEgress) {
+		ruleType = securityGroupRuleTypeEgress
+	}
+
+	d.Set("security_group_id", securityGroupID)
+	d.Set("security_group_rule_id", rule.SecurityGroupRuleId)
+	d.Set("type", ruleType)
+	d.Set("protocol", ProtocolForValue(aws.StringValue(rule.IpProtocol)))
+	d.Set("from_port", rule.FromPort)
+	d.Set("to_port", rule.ToPort)
+	d.Set("self", false)
+
+	switch {
+	case aws.StringValue(rule.CidrIpv4) != "":
+		d.Set("cidr_blocks", []string{aws.StringValue(rule.CidrIpv4)})
+	case aws.StringValue(rule.CidrIpv6) != "":
+		d.Set("ipv6_cidr_blocks", []string{aws.StringValue(rule.CidrIpv6)})
+	case aws.StringValue(rule.PrefixListId) != "":
+		d.Set("prefix_list_ids", []string{aws.StringValue(rule.PrefixListId)})
+	case rule.ReferencedGroupInfo != nil:
+		if groupID := aws.StringValue(rule.ReferencedGroupInfo.GroupId); groupID == securityGroupID {
+			d.Set("self", true)
+		} else {
+			d.Set("source_security_group_id", groupID)
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func findRuleMatch(p *ec2.IpPermission, rules []*ec2.IpPermission, isVPC bool) (*ec2.IpPermission, string) {
 	var rule *ec2.IpPermission
 	var description string
@@ -682,7 +728,10 @@ func findSecurityGroupRuleMatch(p *ec2.IpPermission, securityGroupRules []*ec2.S
 	return ""
 }
 
-const securityGroupRuleIDSeparator = "_"
+const (
+	securityGroupRuleIDPrefix    = "sgr-"
+	securityGroupRuleIDSeparator = "_"
+)
 
 // byGroupPair implements sort.Interface for []*ec2.UserIDGroupPairs based on
 // GroupID or GroupName field (only one should be set).
@@ -2033,17 +2033,12 @@ func WaitCustomerGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string) (
 	return nil, err
 }
 
-const (
-	natGatewayCreatedTimeout = 10 * time.Minute
-	natGatewayDeletedTimeout = 30 * time.Minute
-)
-
-func WaitNATGatewayCreated(ctx context.Context, conn *ec2.EC2, id string) (*ec2.NatGateway, error) {
+func WaitNATGatewayCreated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.NatGateway, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ec2.NatGatewayStatePending},
 		Target:  []string{ec2.NatGatewayStateAvailable},
 		Refresh: StatusNATGatewayState(ctx, conn, id),
-		Timeout: natGatewayCreatedTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -2059,12 +2054,12 @@ func WaitNATGatewayCreated(ctx context.Context, conn *ec2.EC2, id string) (*ec2.
 	return nil, err
 }
 
-func WaitNATGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string) (*ec2.NatGateway, error) {
+func WaitNATGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.NatGateway, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{ec2.NatGatewayStateDeleting},
 		Target:     []string{},
 		Refresh:    StatusNATGatewayState(ctx, conn, id),
-		Timeout:    natGatewayDeletedTimeout,
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
@@ -2082,6 +2077,90 @@ func WaitNATGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string) (*ec2.
 	return nil, err
 }
 
+func WaitNATGatewayAddressAssigned(ctx context.Context, conn *ec2.EC2, natGatewayID, privateIP string, timeout time.Duration) (*ec2.NatGatewayAddress, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.NatGatewayAddressStatusAssigning},
+		Target:  []string{ec2.NatGatewayAddressStatusSucceeded},
+		Refresh: StatusNATGatewayAddressByNATGatewayIDAndPrivateIP(ctx, conn, natGatewayID, privateIP),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.NatGatewayAddress); ok {
+		if status := aws.StringValue(output.Status); status == ec2.NatGatewayAddressStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitNATGatewayAddressUnassigned(ctx context.Context, conn *ec2.EC2, natGatewayID, privateIP string, timeout time.Duration) (*ec2.NatGatewayAddress, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.NatGatewayAddressStatusUnassigning},
+		Target:  []string{},
+		Refresh: StatusNATGatewayAddressByNATGatewayIDAndPrivateIP(ctx, conn, natGatewayID, privateIP),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.NatGatewayAddress); ok {
+		if status := aws.StringValue(output.Status); status == ec2.NatGatewayAddressStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitNATGatewayAddressAssociated(ctx context.Context, conn *ec2.EC2, natGatewayID, allocationID string, timeout time.Duration) (*ec2.NatGatewayAddress, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.NatGatewayAddressStatusAssociating},
+		Target:  []string{ec2.NatGatewayAddressStatusSucceeded},
+		Refresh: StatusNATGatewayAddressByNATGatewayIDAndAllocationID(ctx, conn, natGatewayID, allocationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.NatGatewayAddress); ok {
+		if status := aws.StringValue(output.Status); status == ec2.NatGatewayAddressStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitNATGatewayAddressDisassociated(ctx context.Context, conn *ec2.EC2, natGatewayID, allocationID string, timeout time.Duration) (*ec2.NatGatewayAddress, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.NatGatewayAddressStatusDisassociating},
+		Target:  []string{},
+		Refresh: StatusNATGatewayAddressByNATGatewayIDAndAllocationID(ctx, conn, natGatewayID, allocationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.NatGatewayAddress); ok {
+		if status := aws.StringValue(output.Status); status == ec2.NatGatewayAddressStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	vpnConnectionCreatedTimeout = 40 * time.Minute
 	vpnConnectionDeletedTimeout = 30 * time.Minute
@@ -2185,9 +2264,10 @@ func WaitVPNConnectionRouteDeleted(ctx context.Context, conn *ec2.EC2, vpnConnec
 }
 
 const (
-	HostCreatedTimeout = 10 * time.Minute
-	HostUpdatedTimeout = 10 * time.Minute
-	HostDeletedTimeout = 20 * time.Minute
+	HostCreatedTimeout      = 10 * time.Minute
+	HostUpdatedTimeout      = 10 * time.Minute
+	HostDeletedTimeout      = 20 * time.Minute
+	HostReleaseRetryTimeout = 10 * time.Minute
 )
 
 func WaitHostCreated(ctx context.Context, conn *ec2.EC2, id string) (*ec2.Host, error) {
@@ -2666,6 +2746,25 @@ func WaitVPCEndpointServiceDeleted(ctx context.Context, conn *ec2.EC2, id string
 	return nil, err
 }
 
+func WaitVPCEndpointServicePrivateDNSNameVerified(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.PrivateDnsNameConfiguration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.DnsNameStatePendingVerification},
+		Target:     []string{ec2.DnsNameStateVerified},
+		Refresh:    StatusVPCEndpointServicePrivateDNSNameConfiguration(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.PrivateDnsNameConfiguration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitVPCEndpointRouteTableAssociationDeleted(ctx context.Context, conn *ec2.EC2, vpcEndpointID, routeTableID string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:                   []string{VPCEndpointRouteTableAssociationStatusReady},
@@ -2763,6 +2862,46 @@ func waitEBSSnapshotTierArchive(ctx context.Context, conn *ec2.EC2, id string, t
 	return nil, err
 }
 
+func WaitFastSnapshotRestoreEnabled(ctx context.Context, conn *ec2.EC2, snapshotID, availabilityZone string, timeout time.Duration) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.FastSnapshotRestoreStateCodeEnabling, ec2.FastSnapshotRestoreStateCodeOptimizing},
+		Target:  []string{ec2.FastSnapshotRestoreStateCodeEnabled},
+		Refresh: StatusFastSnapshotRestoreState(ctx, conn, snapshotID, availabilityZone),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.DescribeFastSnapshotRestoreSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitFastSnapshotRestoreDisabled(ctx context.Context, conn *ec2.EC2, snapshotID, availabilityZone string, timeout time.Duration) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.FastSnapshotRestoreStateCodeDisabling, ec2.FastSnapshotRestoreStateCodeEnabled, ec2.FastSnapshotRestoreStateCodeEnabling, ec2.FastSnapshotRestoreStateCodeOptimizing},
+		Target:  []string{},
+		Refresh: StatusFastSnapshotRestoreState(ctx, conn, snapshotID, availabilityZone),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.DescribeFastSnapshotRestoreSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitIPAMCreated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Ipam, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ec2.IpamStateCreateInProgress},
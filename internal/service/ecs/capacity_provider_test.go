@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -114,6 +115,7 @@ func TestAccECSCapacityProvider_managedScaling(t *testing.T) {
 				Config: testAccCapacityProviderConfig_managedScaling(rName, ecs.ManagedScalingStatusDisabled, 400, 100, 10, 100),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCapacityProviderExists(ctx, resourceName, &provider),
+					testAccCheckCapacityProviderUpdatedInPlace(&provider),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttrPair(resourceName, "auto_scaling_group_provider.0.auto_scaling_group_arn", "aws_autoscaling_group.test", "arn"),
 					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_termination_protection", "DISABLED"),
@@ -262,6 +264,18 @@ func testAccCheckCapacityProviderExists(ctx context.Context, resourceName string
 	}
 }
 
+// testAccCheckCapacityProviderUpdatedInPlace checks that the capacity provider was updated rather than replaced.
+// A replacement capacity provider has no update status.
+func testAccCheckCapacityProviderUpdatedInPlace(provider *ecs.CapacityProvider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got, want := aws.StringValue(provider.UpdateStatus), ecs.CapacityProviderUpdateStatusUpdateComplete; got != want {
+			return fmt.Errorf("ECS Capacity Provider (%s) update status is %q, expected %q", aws.StringValue(provider.CapacityProviderArn), got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCapacityProviderConfig_base(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAvailableAZsNoOptInDefaultExclude(),
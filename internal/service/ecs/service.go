@@ -1580,3 +1580,50 @@ func buildFamilyAndRevisionFromARN(arn string) string {
 func getNameFromARN(arn string) string {
 	return strings.Split(arn, "/")[1]
 }
+
+// serviceDeploymentEventsMax is the maximum number of service events reported when a deployment fails.
+const serviceDeploymentEventsMax = 5
+
+func primaryServiceDeployment(service *ecs.Service) *ecs.Deployment {
+	for _, v := range service.Deployments {
+		if aws.StringValue(v.Status) == serviceDeploymentStatusPrimary {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// serviceDeploymentError returns an error describing why the service's primary deployment
+// has not reached a steady state, built from the deployment's rollout state reason and the
+// most recent service events since the deployment started.
+func serviceDeploymentError(service *ecs.Service) error {
+	var messages []string
+
+	deployment := primaryServiceDeployment(service)
+
+	if deployment != nil {
+		if v := aws.StringValue(deployment.RolloutStateReason); v != "" {
+			messages = append(messages, v)
+		}
+	}
+
+	// Service events are returned newest first.
+	for i, v := range service.Events {
+		if i >= serviceDeploymentEventsMax {
+			break
+		}
+
+		if deployment != nil && deployment.CreatedAt != nil && v.CreatedAt != nil && v.CreatedAt.Before(aws.TimeValue(deployment.CreatedAt)) {
+			break
+		}
+
+		messages = append(messages, fmt.Sprintf("%s: %s", aws.TimeValue(v.CreatedAt).Format(time.RFC3339), aws.StringValue(v.Message)))
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(messages, "\n"))
+}
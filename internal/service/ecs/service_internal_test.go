@@ -0,0 +1,89 @@
+package ecs
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestServiceDeploymentError(t *testing.T) {
+	t.Parallel()
+
+	deployed := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		service  *ecs.Service
+		expected []string
+		absent   []string
+		nilError bool
+	}{
+		{
+			name:     "no deployments or events",
+			service:  &ecs.Service{},
+			nilError: true,
+		},
+		{
+			name: "rollout state reason and recent events",
+			service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					{
+						CreatedAt:          aws.Time(deployed),
+						Id:                 aws.String("ecs-svc/1"),
+						RolloutState:       aws.String(ecs.DeploymentRolloutStateFailed),
+						RolloutStateReason: aws.String("ECS deployment circuit breaker: tasks failed to start."),
+						Status:             aws.String(serviceDeploymentStatusPrimary),
+					},
+				},
+				Events: []*ecs.ServiceEvent{
+					{
+						CreatedAt: aws.Time(deployed.Add(2 * time.Minute)),
+						Message:   aws.String("(service test) has stopped 1 running tasks: (task 1)."),
+					},
+					{
+						CreatedAt: aws.Time(deployed.Add(-time.Minute)),
+						Message:   aws.String("(service test) has reached a steady state."),
+					},
+				},
+			},
+			expected: []string{"circuit breaker", "has stopped 1 running tasks"},
+			absent:   []string{"steady state"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := serviceDeploymentError(testCase.service)
+
+			if testCase.nilError {
+				if err != nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			for _, v := range testCase.expected {
+				if !strings.Contains(err.Error(), v) {
+					t.Errorf("expected error to contain %q, got: %s", v, err)
+				}
+			}
+
+			for _, v := range testCase.absent {
+				if strings.Contains(err.Error(), v) {
+					t.Errorf("expected error not to contain %q, got: %s", v, err)
+				}
+			}
+		})
+	}
+}
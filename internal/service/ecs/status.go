@@ -2,6 +2,7 @@ package ecs
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -17,6 +18,8 @@ const (
 	serviceStatusPending = "tfPENDING"
 	serviceStatusStable  = "tfSTABLE"
 
+	serviceDeploymentStatusPrimary = "PRIMARY"
+
 	clusterStatusError = "ERROR"
 	clusterStatusNone  = "NONE"
 
@@ -84,6 +87,14 @@ func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster
 
 		service := serviceRaw.(*ecs.Service)
 
+		if v := primaryServiceDeployment(service); v != nil && aws.StringValue(v.RolloutState) == ecs.DeploymentRolloutStateFailed {
+			if err := serviceDeploymentError(service); err != nil {
+				return service, "", fmt.Errorf("deployment (%s) failed: %w", aws.StringValue(v.Id), err)
+			}
+
+			return service, "", fmt.Errorf("deployment (%s) failed", aws.StringValue(v.Id))
+		}
+
 		if d, dc, rc := len(service.Deployments),
 			aws.Int64Value(service.DesiredCount),
 			aws.Int64Value(service.RunningCount); d == 1 && dc == rc {
@@ -48,6 +48,7 @@ func ResourceTaskDefinition() *schema.Resource {
 					return nil, idErr
 				}
 				d.SetId(familyRevisionParts[0])
+				d.Set("track_latest", false)
 
 				return []*schema.ResourceData{d}, nil
 			},
@@ -269,6 +270,11 @@ func ResourceTaskDefinition() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"track_latest": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"volume": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -573,8 +579,15 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 
 	log.Printf("[DEBUG] Reading task definition %s", d.Id())
 
+	taskDefinitionName := d.Get("arn").(string)
+	// Describing by family returns the latest ACTIVE revision, which may have been
+	// registered outside of Terraform.
+	if d.Get("track_latest").(bool) {
+		taskDefinitionName = d.Id()
+	}
+
 	input := ecs.DescribeTaskDefinitionInput{
-		TaskDefinition: aws.String(d.Get("arn").(string)),
+		TaskDefinition: aws.String(taskDefinitionName),
 		Include:        []*string{aws.String(ecs.TaskDefinitionFieldTags)},
 	}
 
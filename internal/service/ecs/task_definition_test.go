@@ -103,6 +103,41 @@ func TestAccECSTaskDefinition_basic(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_trackLatest(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def ecs.TaskDefinition
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_trackLatest(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "revision", "1"),
+					resource.TestCheckResourceAttr(resourceName, "track_latest", "true"),
+					testAccCheckTaskDefinitionRegisterRevision(ctx, &def),
+				),
+			},
+			{
+				Config: testAccTaskDefinitionConfig_trackLatest(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "revision", "2"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ecs", regexp.MustCompile(`task-definition/.+:2$`)),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn_without_revision", "ecs", regexp.MustCompile(`task-definition/[^:]+$`)),
+				),
+			},
+		},
+	})
+}
+
 // Regression for https://github.com/hashicorp/terraform/issues/2370
 func TestAccECSTaskDefinition_scratchVolume(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -1275,6 +1310,21 @@ func testAccCheckTaskDefinitionExists(ctx context.Context, name string, def *ecs
 	}
 }
 
+// testAccCheckTaskDefinitionRegisterRevision registers a new revision of the task definition's family
+// outside of Terraform, as a CI pipeline would.
+func testAccCheckTaskDefinitionRegisterRevision(ctx context.Context, def *ecs.TaskDefinition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn()
+
+		_, err := conn.RegisterTaskDefinitionWithContext(ctx, &ecs.RegisterTaskDefinitionInput{
+			ContainerDefinitions: def.ContainerDefinitions,
+			Family:               def.Family,
+		})
+
+		return err
+	}
+}
+
 func testAccCheckTaskDefinitionDockerVolumeConfigurationAutoprovisionNil(def *ecs.TaskDefinition) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if len(def.Volumes) != 1 {
@@ -1348,6 +1398,29 @@ TASK_DEFINITION
 `, rName))
 }
 
+func testAccTaskDefinitionConfig_trackLatest(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family       = %[1]q
+  track_latest = true
+
+  container_definitions = <<TASK_DEFINITION
+[
+	{
+		"cpu": 10,
+		"command": ["sleep", "10"],
+		"entryPoint": ["/"],
+		"essential": true,
+		"image": "jenkins",
+		"memory": 128,
+		"name": "jenkins"
+	}
+]
+TASK_DEFINITION
+}
+`, rName)
+}
+
 func testAccTaskDefinitionConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_task_definition" "test" {
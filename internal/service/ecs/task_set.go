@@ -478,7 +478,7 @@ func resourceTaskSetUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECSConn()
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChange("scale") {
 		taskSetId, service, cluster, err := TaskSetParseID(d.Id())
 
 		if err != nil {
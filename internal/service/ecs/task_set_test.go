@@ -132,6 +132,45 @@ func TestAccECSTaskSet_withScale(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskSet_withScaleWaitUntilStable(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskSetConfig_scaleWaitUntilStable(rName, 0.0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale.0.value", "0"),
+					resource.TestCheckResourceAttr(resourceName, "stability_status", ecs.StabilityStatusSteadyState),
+				),
+			},
+			{
+				Config: testAccTaskSetConfig_scaleWaitUntilStable(rName, 50.0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale.0.value", "50"),
+					resource.TestCheckResourceAttr(resourceName, "stability_status", ecs.StabilityStatusSteadyState),
+				),
+			},
+			{
+				Config: testAccTaskSetConfig_scaleWaitUntilStable(rName, 100.0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale.0.value", "100"),
+					resource.TestCheckResourceAttr(resourceName, "stability_status", ecs.StabilityStatusSteadyState),
+				),
+			},
+		},
+	})
+}
+
 func TestAccECSTaskSet_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -492,6 +531,23 @@ resource "aws_ecs_task_set" "test" {
 `, scale))
 }
 
+func testAccTaskSetConfig_scaleWaitUntilStable(rName string, scale float64) string {
+	return acctest.ConfigCompose(
+		testAccTaskSetBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_ecs_task_set" "test" {
+  service           = aws_ecs_service.test.id
+  cluster           = aws_ecs_cluster.test.id
+  task_definition   = aws_ecs_task_definition.test.arn
+  wait_until_stable = true
+
+  scale {
+    value = %[1]f
+  }
+}
+`, scale))
+}
+
 func testAccTaskSetConfig_capacityProviderStrategy(rName string, weight, base int) string {
 	return acctest.ConfigCompose(
 		testAccCapacityProviderConfig_base(rName),
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -26,6 +27,7 @@ const (
 
 	taskSetCreateTimeout = 10 * time.Minute
 	taskSetDeleteTimeout = 10 * time.Minute
+	taskSetStableDelay   = 10 * time.Second
 )
 
 func waitCapacityProviderDeleted(ctx context.Context, conn *ecs.ECS, arn string) (*ecs.CapacityProvider, error) {
@@ -72,15 +74,31 @@ func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, t
 		input.Cluster = aws.String(cluster)
 	}
 
+	// Keep track of the last service seen so that its events can be reported on timeout.
+	var lastService *ecs.Service
+	refresh := statusServiceWaitForStable(ctx, conn, id, cluster)
+
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{serviceStatusInactive, serviceStatusDraining, serviceStatusPending},
 		Target:  []string{serviceStatusStable},
-		Refresh: statusServiceWaitForStable(ctx, conn, id, cluster),
+		Refresh: func() (interface{}, string, error) {
+			outputRaw, status, err := refresh()
+
+			if v, ok := outputRaw.(*ecs.Service); ok {
+				lastService = v
+			}
+
+			return outputRaw, status, err
+		},
 		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
+	if lastService != nil {
+		tfresource.SetLastError(err, serviceDeploymentError(lastService))
+	}
+
 	if v, ok := outputRaw.(*ecs.Service); ok {
 		return v, err
 	}
@@ -170,6 +188,10 @@ func waitTaskSetStable(ctx context.Context, conn *ecs.ECS, timeout time.Duration
 		Target:  []string{ecs.StabilityStatusSteadyState},
 		Refresh: stabilityStatusTaskSet(ctx, conn, taskSetID, service, cluster),
 		Timeout: timeout,
+		// A task set can still report STEADY_STATE right after a scale update,
+		// before ECS starts launching or draining tasks.
+		Delay:                     taskSetStableDelay,
+		ContinuousTargetOccurence: 2,
 	}
 
 	_, err := stateConf.WaitForStateContext(ctx)
@@ -67,9 +67,10 @@ func ResourceAddon() *schema.Resource {
 				ValidateFunc: validClusterName,
 			},
 			"configuration_values": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 			},
 			"created_at": {
 				Type:     schema.TypeString,
@@ -253,6 +253,7 @@ func TestAccEKSAddon_configurationValues(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_addon.test"
 	configurationValues := "{\"env\": {\"WARM_ENI_TARGET\":\"2\",\"ENABLE_POD_ENI\":\"true\"},\"resources\": {\"limits\":{\"cpu\":\"100m\",\"memory\":\"100Mi\"},\"requests\":{\"cpu\":\"100m\",\"memory\":\"100Mi\"}}}"
+	reorderedConfigurationValues := "{\"resources\": {\"requests\":{\"memory\":\"100Mi\",\"cpu\":\"100m\"},\"limits\":{\"memory\":\"100Mi\",\"cpu\":\"100m\"}},\"env\": {\"ENABLE_POD_ENI\":\"true\",\"WARM_ENI_TARGET\":\"2\"}}"
 	updateConfigurationValues := "{\"env\": {\"WARM_ENI_TARGET\":\"2\",\"ENABLE_POD_ENI\":\"true\"},\"resources\": {\"limits\":{\"cpu\":\"200m\",\"memory\":\"150Mi\"},\"requests\":{\"cpu\":\"200m\",\"memory\":\"150Mi\"}}}"
 	emptyConfigurationValues := "{}"
 	invalidConfigurationValues := "{\"env\": {\"INVALID_FIELD\":\"2\"}}"
@@ -278,6 +279,10 @@ func TestAccEKSAddon_configurationValues(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"resolve_conflicts"},
 			},
+			{
+				Config:   testAccAddonConfig_configurationValues(rName, addonName, addonVersion, reorderedConfigurationValues, eks.ResolveConflictsOverwrite),
+				PlanOnly: true,
+			},
 			{
 				Config: testAccAddonConfig_configurationValues(rName, addonName, addonVersion, updateConfigurationValues, eks.ResolveConflictsOverwrite),
 				Check: resource.ComposeTestCheckFunc(
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -228,6 +229,30 @@ func ResourceReplicationGroup() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"cluster_mode.0.num_node_groups", "num_node_groups", "number_cache_clusters"},
 			},
+			"node_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"primary_endpoint_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reader_endpoint_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slots": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"num_node_groups": {
 				Type:          schema.TypeInt,
 				Optional:      true,
@@ -400,6 +425,10 @@ func ResourceReplicationGroup() *schema.Resource {
 					diff.HasChange("num_node_groups") ||
 					diff.HasChange("replicas_per_node_group")
 			}),
+			customdiff.ComputedIf("node_groups", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("cluster_mode.0.num_node_groups") ||
+					diff.HasChange("num_node_groups")
+			}),
 			verify.SetTagsDiff,
 		),
 	}
@@ -673,6 +702,9 @@ func resourceReplicationGroupRead(ctx context.Context, d *schema.ResourceData, m
 		return sdkdiag.AppendErrorf(diags, "setting cluster_mode attribute: %s", err)
 	}
 
+	if err := d.Set("node_groups", flattenNodeGroups(rgp.NodeGroups)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting node_groups: %s", err)
+	}
 	d.Set("num_node_groups", len(rgp.NodeGroups))
 	d.Set("replicas_per_node_group", len(rgp.NodeGroups[0].NodeGroupMembers)-1)
 
@@ -1109,6 +1141,33 @@ func flattenNodeGroupsToClusterMode(nodeGroups []*elasticache.NodeGroup) []map[s
 	return []map[string]interface{}{m}
 }
 
+func flattenNodeGroups(nodeGroups []*elasticache.NodeGroup) []interface{} {
+	tfList := make([]interface{}, 0, len(nodeGroups))
+
+	for _, nodeGroup := range nodeGroups {
+		if nodeGroup == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"node_group_id": aws.StringValue(nodeGroup.NodeGroupId),
+			"slots":         aws.StringValue(nodeGroup.Slots),
+		}
+
+		if v := nodeGroup.PrimaryEndpoint; v != nil {
+			tfMap["primary_endpoint_address"] = aws.StringValue(v.Address)
+		}
+
+		if v := nodeGroup.ReaderEndpoint; v != nil {
+			tfMap["reader_endpoint_address"] = aws.StringValue(v.Address)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func modifyReplicationGroupShardConfiguration(ctx context.Context, conn *elasticache.ElastiCache, d *schema.ResourceData) error {
 	if d.HasChange("cluster_mode.0.num_node_groups") {
 		err := modifyReplicationGroupShardConfigurationNumNodeGroups(ctx, conn, d, "cluster_mode.0.num_node_groups")
@@ -1153,14 +1212,22 @@ func modifyReplicationGroupShardConfigurationNumNodeGroups(ctx context.Context,
 	}
 
 	if oldNumNodeGroups > newNumNodeGroups {
-		// Node Group IDs are 1 indexed: 0001 through 0015
-		// Loop from highest old ID until we reach highest new ID
-		nodeGroupsToRemove := []string{}
-		for i := oldNumNodeGroups; i > newNumNodeGroups; i-- {
-			nodeGroupID := fmt.Sprintf("%04d", i)
-			nodeGroupsToRemove = append(nodeGroupsToRemove, nodeGroupID)
+		rg, err := FindReplicationGroupByID(ctx, conn, d.Id())
+		if err != nil {
+			return fmt.Errorf("error reading ElastiCache Replication Group (%s): %w", d.Id(), err)
+		}
+
+		// Node Group IDs are usually 0001 through 0500, but can be set explicitly,
+		// so remove the highest existing IDs until only the new count remains.
+		nodeGroupIDs := make([]string, 0, len(rg.NodeGroups))
+		for _, nodeGroup := range rg.NodeGroups {
+			nodeGroupIDs = append(nodeGroupIDs, aws.StringValue(nodeGroup.NodeGroupId))
+		}
+		sort.Strings(nodeGroupIDs)
+
+		if len(nodeGroupIDs) > newNumNodeGroups {
+			input.NodeGroupsToRemove = aws.StringSlice(nodeGroupIDs[newNumNodeGroups:])
 		}
-		input.NodeGroupsToRemove = aws.StringSlice(nodeGroupsToRemove)
 	}
 
 	log.Printf("[DEBUG] Modifying ElastiCache Replication Group (%s) shard configuration: %s", d.Id(), input)
@@ -1169,7 +1236,7 @@ func modifyReplicationGroupShardConfigurationNumNodeGroups(ctx context.Context,
 		return fmt.Errorf("error modifying ElastiCache Replication Group shard configuration: %w", err)
 	}
 
-	_, err = WaitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+	_, err = waitReplicationGroupResharded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return fmt.Errorf("error waiting for ElastiCache Replication Group (%s) shard reconfiguration completion: %w", d.Id(), err)
 	}
@@ -996,6 +996,17 @@ func TestAccElastiCacheReplicationGroup_ClusterModeUpdateNumNodeGroups_scaleDown
 					resource.TestCheckResourceAttr(resourceName, "cluster_mode.0.replicas_per_node_group", "1"),
 					resource.TestCheckResourceAttr(resourceName, "number_cache_clusters", "6"),
 					resource.TestCheckResourceAttr(resourceName, "member_clusters.#", "6"),
+					resource.TestCheckResourceAttr(resourceName, "node_groups.#", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "node_groups.0.slots"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "node_groups.*", map[string]string{
+						"node_group_id": "0001",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "node_groups.*", map[string]string{
+						"node_group_id": "0002",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "node_groups.*", map[string]string{
+						"node_group_id": "0003",
+					}),
 					testCheckEngineStuffClusterEnabledDefault(ctx, resourceName),
 				),
 			},
@@ -1009,6 +1020,13 @@ func TestAccElastiCacheReplicationGroup_ClusterModeUpdateNumNodeGroups_scaleDown
 					resource.TestCheckResourceAttr(resourceName, "cluster_mode.0.replicas_per_node_group", "1"),
 					resource.TestCheckResourceAttr(resourceName, "number_cache_clusters", "4"),
 					resource.TestCheckResourceAttr(resourceName, "member_clusters.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "node_groups.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "node_groups.*", map[string]string{
+						"node_group_id": "0001",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "node_groups.*", map[string]string{
+						"node_group_id": "0002",
+					}),
 					testCheckEngineStuffClusterEnabledDefault(ctx, resourceName),
 				),
 			},
@@ -19,6 +19,9 @@ const (
 	replicationGroupDeletedMinTimeout = 10 * time.Second
 	replicationGroupDeletedDelay      = 30 * time.Second
 
+	replicationGroupReshardingPollInterval = 30 * time.Second
+	replicationGroupReshardingDelay        = 60 * time.Second
+
 	UserActiveTimeout  = 5 * time.Minute
 	UserDeletedTimeout = 5 * time.Minute
 )
@@ -45,6 +48,30 @@ func WaitReplicationGroupAvailable(ctx context.Context, conn *elasticache.Elasti
 	return nil, err
 }
 
+// waitReplicationGroupResharded waits for online resharding of a ReplicationGroup to complete.
+// Resharding can take a long time and the group may briefly report available before
+// slot migration starts, so the status is polled less often and must be stable.
+func waitReplicationGroupResharded(ctx context.Context, conn *elasticache.ElastiCache, replicationGroupID string, timeout time.Duration) (*elasticache.ReplicationGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ReplicationGroupStatusModifying,
+			ReplicationGroupStatusSnapshotting,
+		},
+		Target:                    []string{ReplicationGroupStatusAvailable},
+		Refresh:                   StatusReplicationGroup(ctx, conn, replicationGroupID),
+		Timeout:                   timeout,
+		Delay:                     replicationGroupReshardingDelay,
+		PollInterval:              replicationGroupReshardingPollInterval,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if v, ok := outputRaw.(*elasticache.ReplicationGroup); ok {
+		return v, err
+	}
+	return nil, err
+}
+
 // WaitReplicationGroupDeleted waits for a ReplicationGroup to be deleted
 func WaitReplicationGroupDeleted(ctx context.Context, conn *elasticache.ElastiCache, replicationGroupID string, timeout time.Duration) (*elasticache.ReplicationGroup, error) {
 	stateConf := &resource.StateChangeConf{
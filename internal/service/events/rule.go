@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -38,6 +39,15 @@ func ResourceRule() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceRuleV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: RuleStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:          schema.TypeString,
@@ -89,9 +99,18 @@ func ResourceRule() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 			},
 			"is_enabled": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				Deprecated:    `Use "state" instead`,
+				ConflictsWith: []string{"state"},
+			},
+			"state": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.StringInSlice(ruleState_Values(), false),
+				ConflictsWith: []string{"is_enabled"},
 			},
 			"arn": {
 				Type:     schema.TypeString,
@@ -101,7 +120,10 @@ func ResourceRule() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceRuleCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -204,6 +226,7 @@ func resourceRuleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	}
 
 	d.Set("is_enabled", enabled)
+	d.Set("state", output.State)
 
 	tags, err := ListTags(ctx, conn, arn)
 
@@ -394,11 +417,52 @@ func buildPutRuleInputStruct(d *schema.ResourceData, name string) (*eventbridge.
 		input.ScheduleExpression = aws.String(v.(string))
 	}
 
-	input.State = aws.String(RuleStateFromEnabled(d.Get("is_enabled").(bool)))
+	if v, ok := d.GetOk("state"); ok {
+		input.State = aws.String(v.(string))
+	} else {
+		input.State = aws.String(RuleStateFromEnabled(d.Get("is_enabled").(bool)))
+	}
 
 	return &input, nil
 }
 
+// resourceRuleCustomizeDiff keeps the deprecated is_enabled argument and state consistent.
+// A rule is enabled when neither is configured.
+func resourceRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := diff.GetRawConfig()
+	rawState := rawConfig.GetAttr("state")
+	rawIsEnabled := rawConfig.GetAttr("is_enabled")
+
+	if !rawState.IsKnown() || !rawIsEnabled.IsKnown() {
+		return nil
+	}
+
+	var state string
+
+	switch {
+	case !rawState.IsNull():
+		state = rawState.AsString()
+	case !rawIsEnabled.IsNull():
+		state = RuleStateFromEnabled(rawIsEnabled.True())
+	default:
+		state = eventbridge.RuleStateEnabled
+	}
+
+	if diff.Get("state").(string) != state {
+		if err := diff.SetNew("state", state); err != nil {
+			return err
+		}
+	}
+
+	if enabled := state != eventbridge.RuleStateDisabled; diff.Get("is_enabled").(bool) != enabled {
+		if err := diff.SetNew("is_enabled", enabled); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func validateEventPatternValue() schema.SchemaValidateFunc {
 	return func(v interface{}, k string) (ws []string, errors []error) {
 		json, err := structure.NormalizeJsonString(v)
@@ -412,7 +476,7 @@ func validateEventPatternValue() schema.SchemaValidateFunc {
 		}
 
 		// Check whether the normalized JSON is within the given length.
-		const maxJSONLength = 2048
+		const maxJSONLength = 4096
 		if len(json) > maxJSONLength {
 			errors = append(errors, fmt.Errorf("%q cannot be longer than %d characters: %q", k, maxJSONLength, json))
 		}
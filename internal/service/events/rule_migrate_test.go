@@ -0,0 +1,71 @@
+package events_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfevents "github.com/hashicorp/terraform-provider-aws/internal/service/events"
+)
+
+func TestRuleStateUpgradeV0(t *testing.T) {
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		rawState map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			testName: "enabled",
+			rawState: map[string]interface{}{
+				"is_enabled": true,
+				"name":       "testrule",
+			},
+			expected: map[string]interface{}{
+				"is_enabled": true,
+				"name":       "testrule",
+				"state":      "ENABLED",
+			},
+		},
+		{
+			testName: "disabled",
+			rawState: map[string]interface{}{
+				"is_enabled": false,
+				"name":       "testrule",
+			},
+			expected: map[string]interface{}{
+				"is_enabled": false,
+				"name":       "testrule",
+				"state":      "DISABLED",
+			},
+		},
+		{
+			testName: "is_enabled missing",
+			rawState: map[string]interface{}{
+				"name": "testrule",
+			},
+			expected: map[string]interface{}{
+				"name":  "testrule",
+				"state": "ENABLED",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := tfevents.RuleStateUpgradeV0(ctx, testCase.rawState, nil)
+			if err != nil {
+				t.Fatalf("error migrating state: %s", err)
+			}
+
+			if !reflect.DeepEqual(testCase.expected, actual) {
+				t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", testCase.expected, actual)
+			}
+		})
+	}
+}
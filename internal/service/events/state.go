@@ -6,9 +6,16 @@ import (
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 )
 
+// ruleStateEnabledWithAllCloudTrailManagementEvents is not yet defined in the AWS SDK for Go.
+const ruleStateEnabledWithAllCloudTrailManagementEvents = "ENABLED_WITH_ALL_CLOUDTRAIL_MANAGEMENT_EVENTS"
+
+func ruleState_Values() []string {
+	return append(eventbridge.RuleState_Values(), ruleStateEnabledWithAllCloudTrailManagementEvents)
+}
+
 // RuleEnabledFromState infers from its state whether or not a rule is enabled.
 func RuleEnabledFromState(state string) (bool, error) {
-	if state == eventbridge.RuleStateEnabled {
+	if state == eventbridge.RuleStateEnabled || state == ruleStateEnabledWithAllCloudTrailManagementEvents {
 		return true, nil
 	}
 
@@ -34,6 +34,11 @@ func TestRuleEnabledFromState(t *testing.T) {
 			State:           "DISABLED",
 			ExpectedEnabled: false,
 		},
+		{
+			TestName:        "enabled with all CloudTrail management events",
+			State:           "ENABLED_WITH_ALL_CLOUDTRAIL_MANAGEMENT_EVENTS",
+			ExpectedEnabled: true,
+		},
 	}
 
 	for _, testCase := range testCases {
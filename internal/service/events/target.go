@@ -535,12 +535,16 @@ func resourceTargetRead(ctx context.Context, d *schema.ResourceData, meta interf
 		if err := d.Set("retry_policy", flattenTargetRetryPolicy(t.RetryPolicy)); err != nil {
 			return diag.Errorf("setting retry_policy: %s", err)
 		}
+	} else {
+		d.Set("retry_policy", nil)
 	}
 
 	if t.DeadLetterConfig != nil {
 		if err := d.Set("dead_letter_config", flattenTargetDeadLetterConfig(t.DeadLetterConfig)); err != nil {
 			return diag.Errorf("setting dead_letter_config: %s", err)
 		}
+	} else {
+		d.Set("dead_letter_config", nil)
 	}
 
 	return nil
@@ -802,7 +806,8 @@ func expandRetryPolicyParameters(rp []interface{}) *eventbridge.RetryPolicy {
 	for _, v := range rp {
 		params := v.(map[string]interface{})
 
-		if val, ok := params["maximum_event_age_in_seconds"].(int); ok {
+		// The API requires a minimum of 60 seconds, so 0 means unset.
+		if val, ok := params["maximum_event_age_in_seconds"].(int); ok && val != 0 {
 			retryPolicy.MaximumEventAgeInSeconds = aws.Int64(int64(val))
 		}
 
@@ -1085,8 +1090,8 @@ func flattenInputTransformer(inputTransformer *eventbridge.InputTransformer) []m
 func flattenTargetRetryPolicy(rp *eventbridge.RetryPolicy) []map[string]interface{} {
 	config := make(map[string]interface{})
 
-	config["maximum_event_age_in_seconds"] = aws.Int64Value(rp.MaximumEventAgeInSeconds)
-	config["maximum_retry_attempts"] = aws.Int64Value(rp.MaximumRetryAttempts)
+	config["maximum_event_age_in_seconds"] = int(aws.Int64Value(rp.MaximumEventAgeInSeconds))
+	config["maximum_retry_attempts"] = int(aws.Int64Value(rp.MaximumRetryAttempts))
 
 	result := []map[string]interface{}{config}
 	return result
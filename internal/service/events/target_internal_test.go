@@ -0,0 +1,130 @@
+package events
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+)
+
+func targetFlattenedToInterfaces(tfList []map[string]interface{}) []interface{} {
+	result := make([]interface{}, len(tfList))
+	for i, v := range tfList {
+		result[i] = v
+	}
+	return result
+}
+
+func TestTargetParametersRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName  string
+		ApiObject interface{}
+		RoundTrip func(interface{}) interface{}
+	}{
+		{
+			TestName: "retry_policy",
+			ApiObject: &eventbridge.RetryPolicy{
+				MaximumEventAgeInSeconds: aws.Int64(60),
+				MaximumRetryAttempts:     aws.Int64(5),
+			},
+			RoundTrip: func(v interface{}) interface{} {
+				return expandRetryPolicyParameters(targetFlattenedToInterfaces(flattenTargetRetryPolicy(v.(*eventbridge.RetryPolicy))))
+			},
+		},
+		{
+			TestName: "retry_policy no retries",
+			ApiObject: &eventbridge.RetryPolicy{
+				MaximumRetryAttempts: aws.Int64(0),
+			},
+			RoundTrip: func(v interface{}) interface{} {
+				return expandRetryPolicyParameters(targetFlattenedToInterfaces(flattenTargetRetryPolicy(v.(*eventbridge.RetryPolicy))))
+			},
+		},
+		{
+			TestName: "dead_letter_config",
+			ApiObject: &eventbridge.DeadLetterConfig{
+				Arn: aws.String("arn:aws:sqs:us-west-2:123456789012:dlq"), //lintignore:AWSAT003,AWSAT005
+			},
+			RoundTrip: func(v interface{}) interface{} {
+				return expandDeadLetterParametersConfig(targetFlattenedToInterfaces(flattenTargetDeadLetterConfig(v.(*eventbridge.DeadLetterConfig))))
+			},
+		},
+		{
+			TestName: "run_command_targets",
+			ApiObject: &eventbridge.RunCommandParameters{
+				RunCommandTargets: []*eventbridge.RunCommandTarget{
+					{
+						Key:    aws.String("tag:Name"),
+						Values: aws.StringSlice([]string{"one", "two"}),
+					},
+				},
+			},
+			RoundTrip: func(v interface{}) interface{} {
+				return expandTargetRunParameters(targetFlattenedToInterfaces(flattenTargetRunParameters(v.(*eventbridge.RunCommandParameters))))
+			},
+		},
+		{
+			TestName: "redshift_target",
+			ApiObject: &eventbridge.RedshiftDataParameters{
+				Database:      aws.String("redshiftdb"),
+				DbUser:        aws.String("admin"),
+				Sql:           aws.String("SELECT * FROM table"),
+				StatementName: aws.String("NewStatement"),
+				WithEvent:     aws.Bool(true),
+			},
+			RoundTrip: func(v interface{}) interface{} {
+				return expandTargetRedshiftParameters(targetFlattenedToInterfaces(flattenTargetRedshiftParameters(v.(*eventbridge.RedshiftDataParameters))))
+			},
+		},
+		{
+			TestName: "batch_target",
+			ApiObject: &eventbridge.BatchParameters{
+				ArrayProperties: &eventbridge.BatchArrayProperties{
+					Size: aws.Int64(5),
+				},
+				JobDefinition: aws.String("job-definition"),
+				JobName:       aws.String("job-name"),
+				RetryStrategy: &eventbridge.BatchRetryStrategy{
+					Attempts: aws.Int64(3),
+				},
+			},
+			RoundTrip: func(v interface{}) interface{} {
+				return expandTargetBatchParameters(targetFlattenedToInterfaces(flattenTargetBatchParameters(v.(*eventbridge.BatchParameters))))
+			},
+		},
+		{
+			TestName: "kinesis_target",
+			ApiObject: &eventbridge.KinesisParameters{
+				PartitionKeyPath: aws.String("$.detail"),
+			},
+			RoundTrip: func(v interface{}) interface{} {
+				return expandTargetKinesisParameters(targetFlattenedToInterfaces(flattenTargetKinesisParameters(v.(*eventbridge.KinesisParameters))))
+			},
+		},
+		{
+			TestName: "sqs_target",
+			ApiObject: &eventbridge.SqsParameters{
+				MessageGroupId: aws.String("event_group"),
+			},
+			RoundTrip: func(v interface{}) interface{} {
+				return expandTargetSQSParameters(targetFlattenedToInterfaces(flattenTargetSQSParameters(v.(*eventbridge.SqsParameters))))
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.RoundTrip(testCase.ApiObject)
+
+			if !reflect.DeepEqual(got, testCase.ApiObject) {
+				t.Errorf("got %s, expected %s", got, testCase.ApiObject)
+			}
+		})
+	}
+}
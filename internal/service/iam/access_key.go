@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -48,6 +49,7 @@ func ResourceAccessKey() *schema.Resource {
 					return nil, fmt.Errorf("error fetching IAM Access Key (%s) username via GetAccessKeyLastUsed: empty response", d.Id())
 				}
 
+				d.Set("deactivate_on_delete", false)
 				d.Set("user", output.UserName)
 
 				return []*schema.ResourceData{d}, nil
@@ -59,6 +61,11 @@ func ResourceAccessKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deactivate_on_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"encrypted_secret": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -76,6 +83,12 @@ func ResourceAccessKey() *schema.Resource {
 				ForceNew: true,
 				Optional: true,
 			},
+			"rotation_triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"secret": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -239,6 +252,27 @@ func resourceAccessKeyDelete(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMConn()
 
+	if d.Get("deactivate_on_delete").(bool) {
+		log.Printf("[DEBUG] Deactivating IAM Access Key (%s) instead of deleting", d.Id())
+		input := &iam.UpdateAccessKeyInput{
+			AccessKeyId: aws.String(d.Id()),
+			Status:      aws.String(iam.StatusTypeInactive),
+			UserName:    aws.String(d.Get("user").(string)),
+		}
+
+		_, err := conn.UpdateAccessKeyWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deactivating IAM Access Key (%s): %s", d.Id(), err)
+		}
+
+		return diags
+	}
+
 	request := &iam.DeleteAccessKeyInput{
 		AccessKeyId: aws.String(d.Id()),
 		UserName:    aws.String(d.Get("user").(string)),
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/iam"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -132,6 +133,42 @@ func TestAccIAMAccessKey_status(t *testing.T) {
 	})
 }
 
+func TestAccIAMAccessKey_rotationTriggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf1, conf2 iam.AccessKeyMetadata
+	resourceName := "aws_iam_access_key.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessKeyConfig_rotationTriggers(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessKeyExists(ctx, resourceName, &conf1),
+					testAccCheckAccessKeyAttributes(&conf1, "Active"),
+					resource.TestCheckResourceAttr(resourceName, "deactivate_on_delete", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_triggers.version", "1"),
+				),
+			},
+			{
+				Config: testAccAccessKeyConfig_rotationTriggers(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessKeyExists(ctx, resourceName, &conf2),
+					testAccCheckAccessKeyAttributes(&conf2, "Active"),
+					testAccCheckAccessKeyRecreated(&conf1, &conf2),
+					testAccCheckAccessKeyStatus(ctx, &conf1, iam.StatusTypeInactive),
+					resource.TestCheckResourceAttr(resourceName, "rotation_triggers.version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAccessKeyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
@@ -193,6 +230,33 @@ func testAccCheckAccessKeyAttributes(accessKeyMetadata *iam.AccessKeyMetadata, s
 	}
 }
 
+func testAccCheckAccessKeyRecreated(before, after *iam.AccessKeyMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(before.AccessKeyId) == aws.StringValue(after.AccessKeyId) {
+			return fmt.Errorf("IAM Access Key (%s) not recreated", aws.StringValue(before.AccessKeyId))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccessKeyStatus(ctx context.Context, accessKeyMetadata *iam.AccessKeyMetadata, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
+
+		accessKey, err := tfiam.FindAccessKey(ctx, conn, aws.StringValue(accessKeyMetadata.UserName), aws.StringValue(accessKeyMetadata.AccessKeyId))
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(accessKey.Status); got != status {
+			return fmt.Errorf("IAM Access Key (%s) status is %s, expected %s", aws.StringValue(accessKey.AccessKeyId), got, status)
+		}
+
+		return nil
+	}
+}
+
 func testDecryptSecretKeyAndTest(nAccessKey, key string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		keyResource, ok := s.RootModule().Resources[nAccessKey]
@@ -266,6 +330,28 @@ resource "aws_iam_access_key" "test" {
 `, rName, status)
 }
 
+func testAccAccessKeyConfig_rotationTriggers(rName, version string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_access_key" "test" {
+  user                 = aws_iam_user.test.name
+  deactivate_on_delete = true
+
+  rotation_triggers = {
+    version = %[2]q
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, rName, version)
+}
+
 func TestSESSMTPPasswordFromSecretKeySigV4(t *testing.T) {
 	t.Parallel()
 
@@ -325,6 +325,8 @@ func instanceProfileReadResult(ctx context.Context, d *schema.ResourceData, resu
 
 	if result.Roles != nil && len(result.Roles) > 0 {
 		d.Set("role", result.Roles[0].RoleName) //there will only be 1 role returned
+	} else {
+		d.Set("role", nil)
 	}
 
 	tags := KeyValueTags(ctx, result.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
@@ -200,6 +200,37 @@ func TestAccIAMInstanceProfile_Disappears_role(t *testing.T) {
 	})
 }
 
+func TestAccIAMInstanceProfile_roleOutOfBandRemovalAddedBack(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf iam.GetInstanceProfileOutput
+	resourceName := "aws_iam_instance_profile.test"
+	rName := sdkacctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceProfileConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceProfileExists(ctx, resourceName, &conf),
+					testAccCheckInstanceProfileRemoveRole(ctx, &conf),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccInstanceProfileConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceProfileExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "role", "aws_iam_role.test", "name"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckInstanceProfileGeneratedNamePrefix(resource, prefix string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		r, ok := s.RootModule().Resources[resource]
@@ -271,6 +302,25 @@ func testAccCheckInstanceProfileExists(ctx context.Context, n string, res *iam.G
 	}
 }
 
+func testAccCheckInstanceProfileRemoveRole(ctx context.Context, res *iam.GetInstanceProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
+
+		for _, role := range res.InstanceProfile.Roles {
+			_, err := conn.RemoveRoleFromInstanceProfileWithContext(ctx, &iam.RemoveRoleFromInstanceProfileInput{
+				InstanceProfileName: res.InstanceProfile.InstanceProfileName,
+				RoleName:            role.RoleName,
+			})
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccInstanceProfileBaseConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test" {
@@ -139,11 +139,11 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	if v, ok := d.GetOk("source_policy_documents"); ok && len(v.([]interface{})) > 0 {
-		// generate sid map to assure there are no duplicates in source jsons
-		sidMap := make(map[string]struct{})
+		// generate sid map to assure there are no conflicting duplicates in source jsons
+		sidMap := make(map[string]*IAMPolicyStatement)
 		for _, stmt := range mergedDoc.Statements {
 			if stmt.Sid != "" {
-				sidMap[stmt.Sid] = struct{}{}
+				sidMap[stmt.Sid] = stmt
 			}
 		}
 
@@ -159,12 +159,21 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 			}
 
 			// assure all statements in sourceDoc are unique before merging
+			// identical statements with the same Sid are de-duplicated by the merge
 			for stmtIndex, stmt := range sourceDoc.Statements {
 				if stmt.Sid != "" {
-					if _, sidExists := sidMap[stmt.Sid]; sidExists {
-						return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging source document %d: duplicate Sid (%s) in source_policy_documents (statement %d). Remove the Sid or ensure Sids are unique.", sourceJSONIndex, stmt.Sid, stmtIndex)
+					if existing, sidExists := sidMap[stmt.Sid]; sidExists {
+						equivalent, err := policyStatementsEquivalent(existing, stmt)
+
+						if err != nil {
+							return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging source document %d: %s", sourceJSONIndex, err)
+						}
+
+						if !equivalent {
+							return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging source document %d: duplicate Sid (%s) in source_policy_documents (statement %d). Remove the Sid or ensure Sids are unique.", sourceJSONIndex, stmt.Sid, stmtIndex)
+						}
 					}
-					sidMap[stmt.Sid] = struct{}{}
+					sidMap[stmt.Sid] = stmt
 				}
 			}
 
@@ -154,6 +154,25 @@ func TestAccIAMPolicyDocumentDataSource_sourceListConflicting(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_sourceListIdenticalSid(t *testing.T) {
+	ctx := acctest.Context(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_listIdenticalSid,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test_source_list_identical_sid", "json",
+						testAccPolicyDocumentSourceListIdenticalSidExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMPolicyDocumentDataSource_override(t *testing.T) {
 	ctx := acctest.Context(t)
 	resource.ParallelTest(t, resource.TestCase{
@@ -981,6 +1000,55 @@ data "aws_iam_policy_document" "test_source_list_conflicting" {
 }
 `
 
+var testAccPolicyDocumentDataSourceConfig_listIdenticalSid = `
+data "aws_iam_policy_document" "policy_a" {
+  statement {
+    sid     = "sharedSid"
+    effect  = "Allow"
+    actions = ["bar:ActionOne"]
+  }
+}
+
+data "aws_iam_policy_document" "policy_b" {
+  statement {
+    sid     = "validSid"
+    effect  = "Deny"
+    actions = ["foo:ActionTwo"]
+  }
+
+  statement {
+    sid     = "sharedSid"
+    effect  = "Allow"
+    actions = ["bar:ActionOne"]
+  }
+}
+
+data "aws_iam_policy_document" "test_source_list_identical_sid" {
+  version = "2012-10-17"
+
+  source_policy_documents = [
+    data.aws_iam_policy_document.policy_a.json,
+    data.aws_iam_policy_document.policy_b.json,
+  ]
+}
+`
+
+var testAccPolicyDocumentSourceListIdenticalSidExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "sharedSid",
+      "Effect": "Allow",
+      "Action": "bar:ActionOne"
+    },
+    {
+      "Sid": "validSid",
+      "Effect": "Deny",
+      "Action": "foo:ActionTwo"
+    }
+  ]
+}`
+
 var testAccPolicyDocumentDataSourceConfig_overrideDeprecated = `
 data "aws_partition" "current" {}
 
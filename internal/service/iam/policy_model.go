@@ -1,6 +1,7 @@
 package iam
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"regexp"
@@ -79,6 +80,21 @@ func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	}
 }
 
+// policyStatementsEquivalent returns whether the two statements produce identical JSON.
+func policyStatementsEquivalent(s1, s2 *IAMPolicyStatement) (bool, error) {
+	b1, err := json.Marshal(s1)
+	if err != nil {
+		return false, err
+	}
+
+	b2, err := json.Marshal(s2)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(b1, b2), nil
+}
+
 func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]interface{}{}
 
@@ -174,18 +190,30 @@ func (cs IAMPolicyStatementConditionSet) MarshalJSON() ([]byte, error) {
 		if _, ok := raw[c.Test]; !ok {
 			raw[c.Test] = map[string]interface{}{}
 		}
+		var values []string
 		switch i := c.Values.(type) {
 		case []string:
-			if _, ok := raw[c.Test][c.Variable]; !ok {
-				raw[c.Test][c.Variable] = make([]string, 0, len(i))
-			}
-			// order matters with values so not sorting here
-			raw[c.Test][c.Variable] = append(raw[c.Test][c.Variable].([]string), i...)
+			values = i
 		case string:
-			raw[c.Test][c.Variable] = i
+			values = []string{i}
 		default:
 			return nil, fmt.Errorf("Unsupported data type for IAMPolicyStatementConditionSet: %s", i)
 		}
+
+		// conditions with the same test and variable have their values merged
+		// order matters with values so not sorting here
+		switch existing := raw[c.Test][c.Variable].(type) {
+		case []string:
+			raw[c.Test][c.Variable] = append(existing, values...)
+		case string:
+			raw[c.Test][c.Variable] = append([]string{existing}, values...)
+		default:
+			if v, ok := c.Values.(string); ok {
+				raw[c.Test][c.Variable] = v
+			} else {
+				raw[c.Test][c.Variable] = append(make([]string, 0, len(values)), values...)
+			}
+		}
 	}
 
 	return json.Marshal(&raw)
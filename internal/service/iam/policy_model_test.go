@@ -262,3 +262,121 @@ func TestIsValidAWSPrincipal(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 		})
 	}
 }
+
+func TestIAMPolicyStatementConditionSetMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		conditions IAMPolicyStatementConditionSet
+		expected   string
+	}{
+		"single_value": {
+			conditions: IAMPolicyStatementConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: "home/"},
+			},
+			expected: `{"StringLike":{"s3:prefix":"home/"}}`,
+		},
+		"multiple_values": {
+			conditions: IAMPolicyStatementConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"home/", "home/${aws:username}/"}},
+			},
+			expected: `{"StringLike":{"s3:prefix":["home/","home/${aws:username}/"]}}`,
+		},
+		"same_test_and_variable_single_values": {
+			conditions: IAMPolicyStatementConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: "home/"},
+				{Test: "StringLike", Variable: "s3:prefix", Values: "home/${aws:username}/"},
+			},
+			expected: `{"StringLike":{"s3:prefix":["home/","home/${aws:username}/"]}}`,
+		},
+		"same_test_and_variable_mixed_values": {
+			conditions: IAMPolicyStatementConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"home/", "home/${aws:username}/"}},
+				{Test: "StringLike", Variable: "s3:prefix", Values: "shared/"},
+			},
+			expected: `{"StringLike":{"s3:prefix":["home/","home/${aws:username}/","shared/"]}}`,
+		},
+		"same_variable_different_tests": {
+			conditions: IAMPolicyStatementConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: "home/"},
+				{Test: "StringNotLike", Variable: "s3:prefix", Values: "home/private/"},
+			},
+			expected: `{"StringLike":{"s3:prefix":"home/"},"StringNotLike":{"s3:prefix":"home/private/"}}`,
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := json.Marshal(testcase.conditions)
+
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if a, e := string(b), testcase.expected; a != e {
+				t.Fatalf("expected %s, got %s", e, a)
+			}
+		})
+	}
+}
+
+func TestPolicyStatementsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		statement1 string
+		statement2 string
+		equivalent bool
+	}{
+		"identical": {
+			statement1: `{"Sid":"S1","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}`,
+			statement2: `{"Sid":"S1","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}`,
+			equivalent: true,
+		},
+		"condition_order": {
+			statement1: `{"Sid":"S1","Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"true"},"StringEquals":{"aws:PrincipalAccount":"123456789012"}}}`,
+			statement2: `{"Sid":"S1","Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalAccount":"123456789012"},"Bool":{"aws:SecureTransport":"true"}}}`,
+			equivalent: true,
+		},
+		"different_action": {
+			statement1: `{"Sid":"S1","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}`,
+			statement2: `{"Sid":"S1","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}`,
+			equivalent: false,
+		},
+		"different_effect": {
+			statement1: `{"Sid":"S1","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}`,
+			statement2: `{"Sid":"S1","Effect":"Deny","Action":"s3:GetObject","Resource":"*"}`,
+			equivalent: false,
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var s1, s2 IAMPolicyStatement
+
+			if err := json.Unmarshal([]byte(testcase.statement1), &s1); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if err := json.Unmarshal([]byte(testcase.statement2), &s2); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			equivalent, err := policyStatementsEquivalent(&s1, &s2)
+
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if a, e := equivalent, testcase.equivalent; a != e {
+				t.Fatalf("expected %t, got %t", e, a)
+			}
+		})
+	}
+}
@@ -79,6 +79,11 @@ func ResourceRole() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"inline_policies_exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"inline_policy": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -171,6 +176,7 @@ func ResourceRole() *schema.Resource {
 
 func resourceRoleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("force_detach_policies", false)
+	d.Set("inline_policies_exclusive", true)
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -319,6 +325,12 @@ func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 		configPoliciesList = expandRoleInlinePolicies(aws.StringValue(role.RoleName), v.List())
 	}
 
+	// When not exclusive, only track the inline policies declared in this resource.
+	// Inline policies managed elsewhere, e.g. with aws_iam_role_policy, are ignored.
+	if !d.Get("inline_policies_exclusive").(bool) {
+		inlinePolicies = filterRoleInlinePolicies(inlinePolicies, configPoliciesList)
+	}
+
 	if !inlinePoliciesEquivalent(inlinePolicies, configPoliciesList) {
 		if err := d.Set("inline_policy", flattenRoleInlinePolicies(inlinePolicies)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting inline_policy: %s", err)
@@ -880,6 +892,23 @@ func readRoleInlinePolicies(ctx context.Context, roleName string, meta interface
 	return apiObjects, nil
 }
 
+// filterRoleInlinePolicies returns those of the specified policies whose names match one of the filter policies.
+func filterRoleInlinePolicies(policies, filter []*iam.PutRolePolicyInput) []*iam.PutRolePolicyInput {
+	names := make(map[string]struct{})
+	for _, policy := range filter {
+		names[aws.StringValue(policy.PolicyName)] = struct{}{}
+	}
+
+	var apiObjects []*iam.PutRolePolicyInput
+	for _, policy := range policies {
+		if _, ok := names[aws.StringValue(policy.PolicyName)]; ok {
+			apiObjects = append(apiObjects, policy)
+		}
+	}
+
+	return apiObjects
+}
+
 func inlinePoliciesActualDiff(d *schema.ResourceData) bool {
 	roleName := d.Get("name").(string)
 	o, n := d.GetChange("inline_policy")
@@ -894,6 +894,43 @@ func TestAccIAMRole_InlinePolicy_outOfBandAdditionRemoved(t *testing.T) {
 	})
 }
 
+func TestAccIAMRole_InlinePolicy_outOfBandAdditionIgnoredNonExclusive(t *testing.T) {
+	ctx := acctest.Context(t)
+	var role iam.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	policyName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	policyName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleConfig_policyInlineNonExclusive(rName, policyName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(ctx, resourceName, &role),
+					resource.TestCheckResourceAttr(resourceName, "inline_policies_exclusive", "false"),
+					testAccCheckRolePolicyAddInlinePolicy(ctx, &role, policyName2),
+				),
+			},
+			{
+				Config: testAccRoleConfig_policyInlineNonExclusive(rName, policyName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(ctx, resourceName, &role),
+					resource.TestCheckResourceAttr(resourceName, "inline_policy.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "inline_policy.*", map[string]string{
+						"name": policyName1,
+					}),
+					testAccCheckRolePolicyRemoveInlinePolicy(ctx, &role, policyName2),
+				),
+			},
+		},
+	})
+}
+
 // TestAccIAMRole_PolicyOutOfBandAdditionIgnored_inlineNonExistent: if there is no
 // inline_policy attribute, out of band changes should be ignored.
 func TestAccIAMRole_InlinePolicy_outOfBandAdditionIgnored(t *testing.T) {
@@ -1779,6 +1816,43 @@ EOF
 `, roleName, policyName)
 }
 
+func testAccRoleConfig_policyInlineNonExclusive(roleName, policyName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole",
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}",
+      }
+      Effect = "Allow"
+      Sid    = ""
+    }]
+  })
+
+  inline_policies_exclusive = false
+
+  inline_policy {
+    name = %[2]q
+
+    policy = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Action   = ["ec2:Describe*"]
+        Effect   = "Allow"
+        Resource = "*"
+      }]
+    })
+  }
+}
+`, roleName, policyName)
+}
+
 func testAccRoleConfig_policyInlineUpdate(roleName, policyName2, policyName3 string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
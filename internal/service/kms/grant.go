@@ -83,8 +83,9 @@ func ResourceGrant() *schema.Resource {
 				Computed: true,
 			},
 			"grant_token": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 			"grantee_principal": {
 				Type:         schema.TypeString,
@@ -236,11 +237,18 @@ func resourceGrantDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	if d.Get("retire_on_delete").(bool) {
+		input := &kms.RetireGrantInput{}
+
+		// A grant can be identified by its token, or by its ID and the key ARN.
+		if v, ok := d.GetOk("grant_token"); ok {
+			input.GrantToken = aws.String(v.(string))
+		} else {
+			input.GrantId = aws.String(grantID)
+			input.KeyId = aws.String(keyID)
+		}
+
 		log.Printf("[DEBUG] Retiring KMS Grant: %s", d.Id())
-		_, err = conn.RetireGrantWithContext(ctx, &kms.RetireGrantInput{
-			GrantId: aws.String(grantID),
-			KeyId:   aws.String(keyID),
-		})
+		_, err = conn.RetireGrantWithContext(ctx, input)
 	} else {
 		log.Printf("[DEBUG] Revoking KMS Grant: %s", d.Id())
 		_, err = conn.RevokeGrantWithContext(ctx, &kms.RevokeGrantInput{
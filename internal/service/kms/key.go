@@ -107,6 +107,12 @@ func ResourceKey() *schema.Resource {
 					return json
 				},
 			},
+			"primary_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -119,6 +125,11 @@ func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
+	// The primary Region can only be changed once replicas of the new key exist.
+	if v, ok := d.GetOk("primary_region"); ok && v.(string) != meta.(*conns.AWSClient).Region {
+		return sdkdiag.AppendErrorf(diags, "creating KMS Key: primary_region (%s) must be the provider Region (%s)", v.(string), meta.(*conns.AWSClient).Region)
+	}
+
 	input := &kms.CreateKeyInput{
 		BypassPolicyLockoutSafetyCheck: aws.Bool(d.Get("bypass_policy_lockout_safety_check").(bool)),
 		CustomerMasterKeySpec:          aws.String(d.Get("customer_master_key_spec").(string)),
@@ -212,8 +223,10 @@ func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s): %s", d.Id(), err)
 	}
 
+	// A key whose primary Region was moved elsewhere via primary_region becomes a replica key.
 	if aws.BoolValue(key.metadata.MultiRegion) &&
-		aws.StringValue(key.metadata.MultiRegionConfiguration.MultiRegionKeyType) != kms.MultiRegionKeyTypePrimary {
+		aws.StringValue(key.metadata.MultiRegionConfiguration.MultiRegionKeyType) != kms.MultiRegionKeyTypePrimary &&
+		d.Get("primary_region").(string) == "" {
 		return sdkdiag.AppendErrorf(diags, "KMS Key (%s) is not a multi-Region primary key", d.Id())
 	}
 
@@ -234,6 +247,12 @@ func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface
 
 	d.Set("policy", policyToSet)
 
+	if aws.BoolValue(key.metadata.MultiRegion) {
+		d.Set("primary_region", key.metadata.MultiRegionConfiguration.PrimaryKey.Region)
+	} else {
+		d.Set("primary_region", nil)
+	}
+
 	tags := key.tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -277,6 +296,25 @@ func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if d.HasChange("primary_region") {
+		o, n := d.GetChange("primary_region")
+		currentPrimaryRegion := o.(string)
+
+		if currentPrimaryRegion == "" {
+			currentPrimaryRegion = meta.(*conns.AWSClient).Region
+		}
+
+		if primaryRegion := n.(string); primaryRegion != "" && primaryRegion != currentPrimaryRegion {
+			if !d.Get("multi_region").(bool) {
+				return sdkdiag.AppendErrorf(diags, "updating KMS Key (%s): primary_region can only be set for multi-Region keys", d.Id())
+			}
+
+			if err := updateKeyPrimaryRegion(ctx, conn, meta.(*conns.AWSClient).TerraformVersion, d.Id(), currentPrimaryRegion, primaryRegion); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating KMS Key (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
 	if hasChange, enabled := d.HasChange("is_enabled"), d.Get("is_enabled").(bool); hasChange && !enabled {
 		// Only disable after all attributes have been modified because we cannot modify disabled keys.
 		if err := updateKeyEnabled(ctx, conn, d.Id(), enabled); err != nil {
@@ -412,6 +450,39 @@ func updateKeyDescription(ctx context.Context, conn *kms.KMS, keyID string, desc
 	return nil
 }
 
+func updateKeyPrimaryRegion(ctx context.Context, conn *kms.KMS, terraformVersion, keyID, currentPrimaryRegion, primaryRegion string) error {
+	// The primary Region is changed from the current primary key's Region.
+	session, err := conns.NewSessionForRegion(&conn.Config, currentPrimaryRegion, terraformVersion)
+
+	if err != nil {
+		return fmt.Errorf("creating AWS session: %w", err)
+	}
+
+	input := &kms.UpdatePrimaryRegionInput{
+		KeyId:         aws.String(keyID),
+		PrimaryRegion: aws.String(primaryRegion),
+	}
+
+	if _, err := kms.New(session).UpdatePrimaryRegionWithContext(ctx, input); err != nil {
+		return fmt.Errorf("updating primary Region: %w", err)
+	}
+
+	// Both the old and the new primary keys are in the Updating state until the update completes.
+	for _, region := range []string{currentPrimaryRegion, primaryRegion} {
+		session, err := conns.NewSessionForRegion(&conn.Config, region, terraformVersion)
+
+		if err != nil {
+			return fmt.Errorf("creating AWS session: %w", err)
+		}
+
+		if err := WaitKeyPrimaryRegionUpdated(ctx, kms.New(session), keyID, primaryRegion); err != nil {
+			return fmt.Errorf("updating primary Region: waiting for completion in %s: %w", region, err)
+		}
+	}
+
+	return nil
+}
+
 func updateKeyEnabled(ctx context.Context, conn *kms.KMS, keyID string, enabled bool) error {
 	var action string
 
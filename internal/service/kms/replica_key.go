@@ -201,8 +201,14 @@ func resourceReplicaKeyRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "KMS Replica Key (%s) has invalid Origin: %s", d.Id(), origin)
 	}
 
-	if !aws.BoolValue(key.metadata.MultiRegion) ||
-		aws.StringValue(key.metadata.MultiRegionConfiguration.MultiRegionKeyType) != kms.MultiRegionKeyTypeReplica {
+	// A replica key can be promoted to primary by changing the primary Region of its multi-Region key.
+	// The promoted key continues to be managed by this resource.
+	promoted := aws.BoolValue(key.metadata.MultiRegion) &&
+		aws.StringValue(key.metadata.MultiRegionConfiguration.MultiRegionKeyType) == kms.MultiRegionKeyTypePrimary &&
+		d.Get("primary_key_arn").(string) != ""
+
+	if !promoted && (!aws.BoolValue(key.metadata.MultiRegion) ||
+		aws.StringValue(key.metadata.MultiRegionConfiguration.MultiRegionKeyType) != kms.MultiRegionKeyTypeReplica) {
 		return sdkdiag.AppendErrorf(diags, "KMS Replica Key (%s) is not a multi-Region replica key", d.Id())
 	}
 
@@ -222,7 +228,9 @@ func resourceReplicaKeyRead(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.Set("policy", policyToSet)
 
-	d.Set("primary_key_arn", key.metadata.MultiRegionConfiguration.PrimaryKey.Arn)
+	if !promoted {
+		d.Set("primary_key_arn", key.metadata.MultiRegionConfiguration.PrimaryKey.Arn)
+	}
 
 	tags := key.tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
@@ -226,6 +226,47 @@ func TestAccKMSReplicaKey_tags(t *testing.T) {
 	})
 }
 
+func TestAccKMSReplicaKey_primaryRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var key kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	primaryKeyResourceName := "aws_kms_key.test"
+	resourceName := "aws_kms_replica_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicaKeyConfig_primaryRegion(rName, acctest.AlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key),
+					resource.TestCheckResourceAttr(primaryKeyResourceName, "primary_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_key_arn", primaryKeyResourceName, "arn"),
+				),
+			},
+			{
+				// Promote the replica key to primary.
+				Config: testAccReplicaKeyConfig_primaryRegion(rName, acctest.Region()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key),
+					resource.TestCheckResourceAttr(primaryKeyResourceName, "primary_region", acctest.Region()),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_key_arn", primaryKeyResourceName, "arn"),
+				),
+			},
+			{
+				Config:   testAccReplicaKeyConfig_primaryRegion(rName, acctest.Region()),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccKMSReplicaKey_twoReplicas(t *testing.T) {
 	ctx := acctest.Context(t)
 	var key kms.KeyMetadata
@@ -266,6 +307,26 @@ resource "aws_kms_replica_key" "test" {
 `, rName))
 }
 
+func testAccReplicaKeyConfig_primaryRegion(rName, primaryRegion string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  provider = awsalternate
+
+  description    = %[1]q
+  multi_region   = true
+  primary_region = %[2]q
+
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_replica_key" "test" {
+  primary_key_arn = aws_kms_key.test.arn
+
+  deletion_window_in_days = 7
+}
+`, rName, primaryRegion))
+}
+
 func testAccReplicaKeyConfig_descriptionAndEnabled(rName, description string, enabled bool) string {
 	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -21,6 +21,7 @@ const (
 	KeyDescriptionPropagationTimeout = 10 * time.Minute
 	KeyMaterialImportedTimeout       = 10 * time.Minute
 	KeyPolicyPropagationTimeout      = 10 * time.Minute
+	KeyPrimaryRegionUpdatedTimeout   = 10 * time.Minute
 	KeyRotationUpdatedTimeout        = 10 * time.Minute
 	KeyStatePropagationTimeout       = 20 * time.Minute
 	KeyTagsPropagationTimeout        = 10 * time.Minute
@@ -122,6 +123,36 @@ func WaitKeyPolicyPropagated(ctx context.Context, conn *kms.KMS, id, policy stri
 	return tfresource.WaitUntil(ctx, KeyPolicyPropagationTimeout, checkFunc, opts)
 }
 
+func WaitKeyPrimaryRegionUpdated(ctx context.Context, conn *kms.KMS, id, primaryRegion string) error {
+	checkFunc := func() (bool, error) {
+		output, err := FindKeyByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		if aws.StringValue(output.KeyState) == kms.KeyStateUpdating {
+			return false, nil
+		}
+
+		if output.MultiRegionConfiguration == nil || output.MultiRegionConfiguration.PrimaryKey == nil {
+			return false, nil
+		}
+
+		return aws.StringValue(output.MultiRegionConfiguration.PrimaryKey.Region) == primaryRegion, nil
+	}
+	opts := tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                2 * time.Second,
+	}
+
+	return tfresource.WaitUntil(ctx, KeyPrimaryRegionUpdatedTimeout, checkFunc, opts)
+}
+
 func WaitKeyRotationEnabledPropagated(ctx context.Context, conn *kms.KMS, id string, enabled bool) error {
 	checkFunc := func() (bool, error) {
 		output, err := FindKeyRotationEnabledByKeyID(ctx, conn, id)
@@ -75,6 +75,10 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"code_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"code_signing_config_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -536,7 +540,7 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
 
-	_, err := retryFunctionOp(ctx, func() (interface{}, error) {
+	outputRaw, err := retryFunctionOp(ctx, func() (interface{}, error) {
 		return conn.CreateFunction(ctx, input)
 	})
 
@@ -558,6 +562,14 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): waiting for completion: %s", d.Id(), err)
 	}
 
+	if input.Publish {
+		version := aws.ToString(outputRaw.(*lambda.CreateFunctionOutput).Version)
+
+		if _, err := waitFunctionVersionActive(ctx, conn, d.Id(), version, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): waiting for version (%s) to become active: %s", d.Id(), version, err)
+		}
+	}
+
 	if v, ok := d.Get("reserved_concurrent_executions").(int); ok && v >= 0 {
 		_, err := conn.PutFunctionConcurrency(ctx, &lambda.PutFunctionConcurrencyInput{
 			FunctionName:                 aws.String(d.Id()),
@@ -654,7 +666,15 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err := d.Set("snap_start", flattenSnapStart(function.SnapStart)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting snap_start: %s", err)
 	}
-	d.Set("source_code_hash", function.CodeSha256)
+	d.Set("code_sha256", function.CodeSha256)
+	// For deployments from a local file source_code_hash is refreshed from CodeSha256 to detect out-of-band code changes.
+	// Otherwise, e.g. for deployments from S3, it is a user-supplied trigger that may not match the CodeSha256 computed by AWS
+	// and is only set when it has no value, such as on import.
+	if _, ok := d.GetOk("filename"); ok {
+		d.Set("source_code_hash", function.CodeSha256)
+	} else if _, ok := d.GetOk("source_code_hash"); !ok {
+		d.Set("source_code_hash", function.CodeSha256)
+	}
 	d.Set("source_code_size", function.CodeSize)
 	d.Set("timeout", function.Timeout)
 	tracingConfigMode := types.TracingModePassThrough
@@ -688,6 +708,13 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 		d.Set("qualified_invoke_arn", functionInvokeARN(qualifiedARN, meta))
 		d.Set("version", latest.Version)
 
+		// SnapStart optimization only applies to published versions.
+		if v := latest.SnapStart; v != nil && function.SnapStart != nil && v.ApplyOn == function.SnapStart.ApplyOn && aws.ToString(latest.Version) != FunctionVersionLatest {
+			if err := d.Set("snap_start", flattenSnapStart(v)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting snap_start: %s", err)
+			}
+		}
+
 		// Tagging operations are permitted on Lambda functions only.
 		// Tags on aliases and versions are not supported.
 		tags := KeyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
@@ -1009,6 +1036,10 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "publishing Lambda Function (%s) version: waiting for completion: %s", d.Id(), err)
 		}
+
+		if _, err := waitFunctionVersionActive(ctx, conn, d.Id(), aws.ToString(output.Version), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "publishing Lambda Function (%s) version: waiting for version (%s) to become active: %s", d.Id(), aws.ToString(output.Version), err)
+		}
 	}
 
 	return append(diags, resourceFunctionRead(ctx, d, meta)...)
@@ -1184,6 +1215,25 @@ func statusFunctionState(ctx context.Context, conn *lambda.Client, name string)
 	}
 }
 
+func statusFunctionVersionState(ctx context.Context, conn *lambda.Client, name, version string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFunction(ctx, conn, &lambda.GetFunctionInput{
+			FunctionName: aws.String(name),
+			Qualifier:    aws.String(version),
+		})
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output.Configuration, string(output.Configuration.State), nil
+	}
+}
+
 func waitFunctionCreated(ctx context.Context, conn *lambda.Client, name string, timeout time.Duration) (*types.FunctionConfiguration, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: enum.Slice(types.StatePending),
@@ -1204,6 +1254,28 @@ func waitFunctionCreated(ctx context.Context, conn *lambda.Client, name string,
 	return nil, err
 }
 
+// waitFunctionVersionActive waits for a published version to become Active.
+// Versions published with SnapStart enabled remain Pending until the snapshot is ready.
+func waitFunctionVersionActive(ctx context.Context, conn *lambda.Client, name, version string, timeout time.Duration) (*types.FunctionConfiguration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: enum.Slice(types.StatePending),
+		Target:  enum.Slice(types.StateActive),
+		Refresh: statusFunctionVersionState(ctx, conn, name, version),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.FunctionConfiguration); ok {
+		tfresource.SetLastError(err, fmt.Errorf("%s: %s", string(output.StateReasonCode), aws.ToString(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitFunctionUpdated(ctx context.Context, conn *lambda.Client, functionName string, timeout time.Duration) (*types.FunctionConfiguration, error) { //nolint:unparam
 	stateConf := &resource.StateChangeConf{
 		Pending: enum.Slice(types.LastUpdateStatusInProgress),
@@ -1290,6 +1362,7 @@ func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff
 	configChanged := needsFunctionConfigUpdate(d)
 	codeChanged := needsFunctionCodeUpdate(d)
 	if codeChanged {
+		d.SetNewComputed("code_sha256")
 		d.SetNewComputed("last_modified")
 	}
 
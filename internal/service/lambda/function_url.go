@@ -29,7 +29,11 @@ func ResourceFunctionURL() *schema.Resource {
 		DeleteWithoutTimeout: resourceFunctionURLDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("create_public_permission", true)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -80,6 +84,11 @@ func ResourceFunctionURL() *schema.Resource {
 					},
 				},
 			},
+			"create_public_permission": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"function_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -141,28 +150,9 @@ func resourceFunctionURLCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(id)
 
-	if v := d.Get("authorization_type").(string); v == lambda.FunctionUrlAuthTypeNone {
-		input := &lambda.AddPermissionInput{
-			Action:              aws.String("lambda:InvokeFunctionUrl"),
-			FunctionName:        aws.String(name),
-			FunctionUrlAuthType: aws.String(v),
-			Principal:           aws.String("*"),
-			StatementId:         aws.String("FunctionURLAllowPublicAccess"),
-		}
-
-		if qualifier != "" {
-			input.Qualifier = aws.String(qualifier)
-		}
-
-		log.Printf("[DEBUG] Adding Lambda Permission: %s", input)
-		_, err := conn.AddPermissionWithContext(ctx, input)
-
-		if err != nil {
-			if tfawserr.ErrMessageContains(err, lambda.ErrCodeResourceConflictException, "The statement id (FunctionURLAllowPublicAccess) provided already exists") {
-				log.Printf("[DEBUG] function permission statement 'FunctionURLAllowPublicAccess' already exists.")
-			} else {
-				return diag.Errorf("error adding Lambda Function URL (%s) permission %s", d.Id(), err)
-			}
+	if d.Get("create_public_permission").(bool) && d.Get("authorization_type").(string) == lambda.FunctionUrlAuthTypeNone {
+		if err := addFunctionURLPublicPermission(ctx, conn, name, qualifier); err != nil {
+			return diag.Errorf("error adding Lambda Function URL (%s) permission %s", d.Id(), err)
 		}
 	}
 
@@ -193,7 +183,8 @@ func resourceFunctionURLRead(ctx context.Context, d *schema.ResourceData, meta i
 	functionURL := aws.StringValue(output.FunctionUrl)
 
 	d.Set("authorization_type", output.AuthType)
-	if output.Cors != nil {
+	// An empty CORS configuration is only kept if the configuration block is present, e.g. "cors {}".
+	if output.Cors != nil && (!isEmptyCors(output.Cors) || len(d.Get("cors").([]interface{})) > 0) {
 		if err := d.Set("cors", []interface{}{flattenCors(output.Cors)}); err != nil {
 			return diag.Errorf("error setting cors: %s", err)
 		}
@@ -254,6 +245,16 @@ func resourceFunctionURLUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("error updating Lambda Function URL (%s): %s", d.Id(), err)
 	}
 
+	if d.Get("create_public_permission").(bool) && d.HasChanges("authorization_type", "create_public_permission") {
+		if d.Get("authorization_type").(string) == lambda.FunctionUrlAuthTypeNone {
+			if err := addFunctionURLPublicPermission(ctx, conn, name, qualifier); err != nil {
+				return diag.Errorf("error adding Lambda Function URL (%s) permission %s", d.Id(), err)
+			}
+		} else if err := removeFunctionURLPublicPermission(ctx, conn, name, qualifier); err != nil {
+			return diag.Errorf("error removing Lambda Function URL (%s) permission %s", d.Id(), err)
+		}
+	}
+
 	return resourceFunctionURLRead(ctx, d, meta)
 }
 
@@ -274,6 +275,12 @@ func resourceFunctionURLDelete(ctx context.Context, d *schema.ResourceData, meta
 		input.Qualifier = aws.String(qualifier)
 	}
 
+	if d.Get("create_public_permission").(bool) {
+		if err := removeFunctionURLPublicPermission(ctx, conn, name, qualifier); err != nil {
+			return diag.Errorf("error removing Lambda Function URL (%s) permission %s", d.Id(), err)
+		}
+	}
+
 	log.Printf("[INFO] Deleting Lambda Function URL: %s", d.Id())
 	_, err = conn.DeleteFunctionUrlConfigWithContext(ctx, input)
 
@@ -317,6 +324,52 @@ func FindFunctionURLByNameAndQualifier(ctx context.Context, conn *lambda.Lambda,
 	return output, nil
 }
 
+const functionURLPublicPermissionStatementID = "FunctionURLAllowPublicAccess"
+
+func addFunctionURLPublicPermission(ctx context.Context, conn *lambda.Lambda, name, qualifier string) error {
+	input := &lambda.AddPermissionInput{
+		Action:              aws.String("lambda:InvokeFunctionUrl"),
+		FunctionName:        aws.String(name),
+		FunctionUrlAuthType: aws.String(lambda.FunctionUrlAuthTypeNone),
+		Principal:           aws.String("*"),
+		StatementId:         aws.String(functionURLPublicPermissionStatementID),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[DEBUG] Adding Lambda Permission: %s", input)
+	_, err := conn.AddPermissionWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, lambda.ErrCodeResourceConflictException, fmt.Sprintf("The statement id (%s) provided already exists", functionURLPublicPermissionStatementID)) {
+		log.Printf("[DEBUG] function permission statement '%s' already exists.", functionURLPublicPermissionStatementID)
+		return nil
+	}
+
+	return err
+}
+
+func removeFunctionURLPublicPermission(ctx context.Context, conn *lambda.Lambda, name, qualifier string) error {
+	input := &lambda.RemovePermissionInput{
+		FunctionName: aws.String(name),
+		StatementId:  aws.String(functionURLPublicPermissionStatementID),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[DEBUG] Removing Lambda Permission: %s", input)
+	_, err := conn.RemovePermissionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
 const functionURLResourceIDSeparator = "/"
 
 func FunctionURLCreateResourceID(functionName, qualifier string) string {
@@ -377,6 +430,17 @@ func expandCors(tfMap map[string]interface{}) *lambda.Cors {
 	return apiObject
 }
 
+// isEmptyCors returns whether the CORS configuration has no settings, as is
+// the case after it has been cleared with an empty Cors object.
+func isEmptyCors(apiObject *lambda.Cors) bool {
+	return !aws.BoolValue(apiObject.AllowCredentials) &&
+		len(apiObject.AllowHeaders) == 0 &&
+		len(apiObject.AllowMethods) == 0 &&
+		len(apiObject.AllowOrigins) == 0 &&
+		len(apiObject.ExposeHeaders) == 0 &&
+		aws.Int64Value(apiObject.MaxAge) == 0
+}
+
 func flattenCors(apiObject *lambda.Cors) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -39,8 +39,10 @@ func TestAccLambdaFunctionURL_basic(t *testing.T) {
 				Config: testAccFunctionURLConfig_basic(funcName, policyName, roleName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					testAccCheckFunctionURLPublicPermission(ctx, resourceName, true),
 					resource.TestCheckResourceAttr(resourceName, "authorization_type", lambda.FunctionUrlAuthTypeNone),
 					resource.TestCheckResourceAttr(resourceName, "cors.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "create_public_permission", "true"),
 					resource.TestCheckResourceAttrSet(resourceName, "function_arn"),
 					resource.TestCheckResourceAttr(resourceName, "function_name", funcName),
 					resource.TestCheckResourceAttrSet(resourceName, "function_url"),
@@ -118,6 +120,16 @@ func TestAccLambdaFunctionURL_Cors(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "cors.0.max_age", "72000"),
 				),
 			},
+			{
+				Config: testAccFunctionURLConfig_corsEmpty(funcName, policyName, roleName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "cors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_credentials", "false"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_origins.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.max_age", "0"),
+				),
+			},
 			{
 				Config: testAccFunctionURLConfig_basic(funcName, policyName, roleName),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -130,6 +142,90 @@ func TestAccLambdaFunctionURL_Cors(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunctionURL_createPublicPermission(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionUrlConfigOutput
+	resourceName := "aws_lambda_function_url.test"
+
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_basic_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_basic_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_basic_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccFunctionURLPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionURLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLConfig_createPublicPermission(funcName, policyName, roleName, lambda.FunctionUrlAuthTypeNone, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					testAccCheckFunctionURLPublicPermission(ctx, resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", lambda.FunctionUrlAuthTypeNone),
+					resource.TestCheckResourceAttr(resourceName, "create_public_permission", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFunctionURLConfig_createPublicPermission(funcName, policyName, roleName, lambda.FunctionUrlAuthTypeAwsIam, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					testAccCheckFunctionURLPublicPermission(ctx, resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", lambda.FunctionUrlAuthTypeAwsIam),
+				),
+			},
+			{
+				Config: testAccFunctionURLConfig_createPublicPermission(funcName, policyName, roleName, lambda.FunctionUrlAuthTypeNone, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					testAccCheckFunctionURLPublicPermission(ctx, resourceName, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURL_createPublicPermissionDisabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionUrlConfigOutput
+	resourceName := "aws_lambda_function_url.test"
+
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_basic_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_basic_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_basic_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccFunctionURLPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionURLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLConfig_createPublicPermission(funcName, policyName, roleName, lambda.FunctionUrlAuthTypeNone, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					testAccCheckFunctionURLPublicPermission(ctx, resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", lambda.FunctionUrlAuthTypeNone),
+					resource.TestCheckResourceAttr(resourceName, "create_public_permission", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_public_permission"},
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunctionURL_Alias(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf lambda.GetFunctionUrlConfigOutput
@@ -248,6 +344,39 @@ func testAccCheckFunctionURLExists(ctx context.Context, n string, v *lambda.GetF
 	}
 }
 
+func testAccCheckFunctionURLPublicPermission(ctx context.Context, n string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		name, qualifier, err := tflambda.FunctionURLParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
+
+		_, err = tflambda.FindPolicyStatementByTwoPartKey(ctx, conn, name, "FunctionURLAllowPublicAccess", qualifier)
+
+		if !exists {
+			if tfresource.NotFound(err) {
+				return nil
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lambda Function URL %s public permission still exists", rs.Primary.ID)
+		}
+
+		return err
+	}
+}
+
 func testAccCheckFunctionURLDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
@@ -427,6 +556,43 @@ resource "aws_lambda_function_url" "test" {
 `, funcName))
 }
 
+func testAccFunctionURLConfig_corsEmpty(funcName, policyName, roleName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs14.x"
+}
+
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = "AWS_IAM"
+
+  cors {}
+}
+`, funcName))
+}
+
+func testAccFunctionURLConfig_createPublicPermission(funcName, policyName, roleName, authorizationType string, createPublicPermission bool) string {
+	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs14.x"
+}
+
+resource "aws_lambda_function_url" "test" {
+  function_name            = aws_lambda_function.test.function_name
+  authorization_type       = %[2]q
+  create_public_permission = %[3]t
+}
+`, funcName, authorizationType, createPublicPermission))
+}
+
 func testAccFunctionURLConfig_alias(funcName, aliasName, policyName, roleName string) string {
 	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
 resource "aws_lambda_function" "test" {
@@ -0,0 +1,142 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	DSNameLayerVersions = "Layer Versions Data Source"
+)
+
+// @SDKDataSource("aws_lambda_layer_versions")
+func DataSourceLayerVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLayerVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"compatible_architecture": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(lambda.Architecture_Values(), false),
+			},
+			"compatible_runtime": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(lambda.Runtime_Values(), false),
+			},
+			"layer_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"layer_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compatible_architectures": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"compatible_runtimes": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"license_info": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLayerVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	layerName := d.Get("layer_name").(string)
+	input := &lambda.ListLayerVersionsInput{
+		LayerName: aws.String(layerName),
+	}
+
+	if v, ok := d.GetOk("compatible_architecture"); ok {
+		input.CompatibleArchitecture = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("compatible_runtime"); ok {
+		input.CompatibleRuntime = aws.String(v.(string))
+	}
+
+	var layerVersions []interface{}
+
+	err := conn.ListLayerVersionsPagesWithContext(ctx, input, func(page *lambda.ListLayerVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LayerVersions {
+			if v == nil {
+				continue
+			}
+
+			layerVersions = append(layerVersions, flattenLayerVersionsListItem(v))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return create.DiagError(names.Lambda, create.ErrActionReading, DSNameLayerVersions, layerName, err)
+	}
+
+	d.SetId(layerName)
+	if err := d.Set("layer_versions", layerVersions); err != nil {
+		return create.DiagError(names.Lambda, create.ErrActionSetting, DSNameLayerVersions, layerName, err)
+	}
+
+	return nil
+}
+
+func flattenLayerVersionsListItem(apiObject *lambda.LayerVersionsListItem) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"arn":                      aws.StringValue(apiObject.LayerVersionArn),
+		"compatible_architectures": aws.StringValueSlice(apiObject.CompatibleArchitectures),
+		"compatible_runtimes":      aws.StringValueSlice(apiObject.CompatibleRuntimes),
+		"created_date":             aws.StringValue(apiObject.CreatedDate),
+		"description":              aws.StringValue(apiObject.Description),
+		"license_info":             aws.StringValue(apiObject.LicenseInfo),
+		"version":                  aws.Int64Value(apiObject.Version),
+	}
+}
@@ -0,0 +1,100 @@
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccLambdaLayerVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_layer_versions.test"
+	resourceName := "aws_lambda_layer_version.test"
+	resource2Name := "aws_lambda_layer_version.test_two"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.#", "2"),
+					// Versions are listed newest first.
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.arn", resource2Name, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.version", resource2Name, "version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.created_date", resource2Name, "created_date"),
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.0.compatible_runtimes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "layer_versions.0.compatible_runtimes.*", "nodejs16.x"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.1.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.1.version", resourceName, "version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.1.created_date", resourceName, "created_date"),
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.1.compatible_runtimes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "layer_versions.1.compatible_runtimes.*", "go1.x"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaLayerVersionsDataSource_compatibleRuntime(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_layer_versions.test"
+	resourceName := "aws_lambda_layer_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionsDataSourceConfig_compatibleRuntime(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.version", resourceName, "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLayerVersionsDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test" {
+  filename            = "test-fixtures/lambdatest.zip"
+  layer_name          = %[1]q
+  compatible_runtimes = ["go1.x"]
+}
+
+resource "aws_lambda_layer_version" "test_two" {
+  filename            = "test-fixtures/lambdatest_modified.zip"
+  layer_name          = aws_lambda_layer_version.test.layer_name
+  compatible_runtimes = ["nodejs16.x"]
+}
+`, rName)
+}
+
+func testAccLayerVersionsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccLayerVersionsDataSourceConfig_base(rName), `
+data "aws_lambda_layer_versions" "test" {
+  layer_name = aws_lambda_layer_version.test_two.layer_name
+}
+`)
+}
+
+func testAccLayerVersionsDataSourceConfig_compatibleRuntime(rName string) string {
+	return acctest.ConfigCompose(testAccLayerVersionsDataSourceConfig_base(rName), `
+data "aws_lambda_layer_versions" "test" {
+  layer_name         = aws_lambda_layer_version.test_two.layer_name
+  compatible_runtime = "go1.x"
+}
+`)
+}
@@ -49,6 +49,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceLayerVersion,
 			TypeName: "aws_lambda_layer_version",
 		},
+		{
+			Factory:  DataSourceLayerVersions,
+			TypeName: "aws_lambda_layer_versions",
+		},
 	}
 }
 
@@ -47,9 +47,12 @@ func resourceSubscriptionFilter() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(cloudwatchlogs.Distribution_Values(), false),
 			},
 			"filter_pattern": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(0, 1024),
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 1024),
+					validLogFilterPattern,
+				),
 			},
 			"log_group_name": {
 				Type:     schema.TypeString,
@@ -109,6 +112,12 @@ func resourceSubscriptionFilterPut(ctx context.Context, d *schema.ResourceData,
 				return true, err
 			}
 
+			// Creating many subscription filters at once can exceed the API's rate limits.
+			// LimitExceededException is not retried as it is also returned for the per log group filter quota.
+			if tfawserr.ErrCodeEquals(err, "ThrottlingException") {
+				return true, err
+			}
+
 			return false, err
 		})
 
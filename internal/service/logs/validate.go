@@ -3,6 +3,7 @@ package logs
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 )
@@ -97,3 +98,57 @@ func validLogMetricFilterTransformationName(v interface{}, k string) (ws []strin
 
 	return
 }
+
+// validLogFilterPattern performs basic syntax checks on a CloudWatch Logs filter pattern.
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/FilterAndPatternSyntax.html
+func validLogFilterPattern(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	var stack []rune
+	// Quoted strings and %regular expressions% are not checked for balanced delimiters.
+	var delimiter rune
+	pairs := map[rune]rune{')': '(', ']': '[', '}': '{'}
+
+	for i, r := range value {
+		if delimiter != 0 {
+			if r == delimiter && (i == 0 || value[i-1] != '\\') {
+				delimiter = 0
+			}
+			continue
+		}
+
+		switch r {
+		case '"', '%':
+			delimiter = r
+		case '(', '[', '{':
+			stack = append(stack, r)
+		case ')', ']', '}':
+			if len(stack) == 0 || stack[len(stack)-1] != pairs[r] {
+				errors = append(errors, fmt.Errorf("%q contains an unexpected %q at position %d: %q", k, r, i, value))
+				return
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	switch delimiter {
+	case '"':
+		errors = append(errors, fmt.Errorf("%q contains an unterminated quoted string: %q", k, value))
+		return
+	case '%':
+		// A lone "%" is a literal character, not the start of a regular expression; nothing more can be checked.
+		return
+	}
+
+	if len(stack) > 0 {
+		errors = append(errors, fmt.Errorf("%q contains an unclosed %q: %q", k, stack[len(stack)-1], value))
+		return
+	}
+
+	// JSON filter patterns must select properties with "$".
+	if trimmed := strings.TrimSpace(value); strings.HasPrefix(trimmed, "{") && !strings.Contains(trimmed, "$") {
+		errors = append(errors, fmt.Errorf("%q is a JSON filter pattern without a property selector (e.g. $.eventType): %q", k, value))
+	}
+
+	return
+}
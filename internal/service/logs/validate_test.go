@@ -175,3 +175,39 @@ func TestValidStreamName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidLogFilterPattern(t *testing.T) {
+	t.Parallel()
+
+	validPatterns := []string{
+		"",
+		"ERROR",
+		`"Failed to process"`,
+		"[ip, user, username, timestamp, request, status_code = 4*, bytes]",
+		`{ $.eventType = "UpdateTrail" }`,
+		`{ ($.errorCode = "*UnauthorizedOperation") || ($.errorCode = "AccessDenied*") }`,
+		`{ $.message = "unbalanced ( inside quotes" }`,
+		"%ERROR [0-9]{3}%",
+	}
+	for _, v := range validPatterns {
+		_, errors := validLogFilterPattern(v, "filter_pattern")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid filter pattern: %q", v, errors)
+		}
+	}
+
+	invalidPatterns := []string{
+		`{ $.eventType = "UpdateTrail"`,
+		`$.eventType = "UpdateTrail" }`,
+		"[ip, user",
+		`{ ($.errorCode = "AccessDenied" }`,
+		`"unterminated`,
+		`{ eventType = "UpdateTrail" }`,
+	}
+	for _, v := range invalidPatterns {
+		_, errors := validLogFilterPattern(v, "filter_pattern")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid filter pattern", v)
+		}
+	}
+}
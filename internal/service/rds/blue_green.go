@@ -4,16 +4,23 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/smithy-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/exp/slices"
 )
 
+// blueGreenUpdateTagKey tags the Blue/Green Deployments created by blue_green_update with the DB Instance identifier.
+const blueGreenUpdateTagKey = "terraform-provider-aws:blue-green-update"
+
 type cleanupWaiterFunc func(context.Context, ...tfresource.OptionsFunc)
 
 type cleanupWaiterErrFunc func(context.Context, ...tfresource.OptionsFunc) error //nolint:unused // WIP
@@ -60,10 +67,13 @@ func (o *blueGreenOrchestrator) waitForDeploymentAvailable(ctx context.Context,
 	return dep, nil
 }
 
-func (o *blueGreenOrchestrator) switchover(ctx context.Context, identifier string, timeout time.Duration) (*types.BlueGreenDeployment, error) {
+func (o *blueGreenOrchestrator) switchover(ctx context.Context, identifier string, switchoverTimeout int, timeout time.Duration) (*types.BlueGreenDeployment, error) {
 	input := &rds_sdkv2.SwitchoverBlueGreenDeploymentInput{
 		BlueGreenDeploymentIdentifier: aws.String(identifier),
 	}
+	if switchoverTimeout > 0 {
+		input.SwitchoverTimeout = aws.Int32(int32(switchoverTimeout))
+	}
 	_, err := tfresource.RetryWhen(ctx, 10*time.Minute,
 		func() (interface{}, error) {
 			return o.conn.SwitchoverBlueGreenDeployment(ctx, input)
@@ -83,6 +93,25 @@ func (o *blueGreenOrchestrator) switchover(ctx context.Context, identifier strin
 	return dep, nil
 }
 
+func (o *blueGreenOrchestrator) deleteDeployment(ctx context.Context, identifier string, deleteTarget bool, timeout time.Duration) error {
+	input := &rds_sdkv2.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(identifier),
+	}
+	if deleteTarget {
+		input.DeleteTarget = aws.Bool(true)
+	}
+	_, err := o.conn.DeleteBlueGreenDeployment(ctx, input)
+	if err != nil {
+		return fmt.Errorf("deleting Blue/Green Deployment: %s", err)
+	}
+
+	_, err = waitBlueGreenDeploymentDeleted(ctx, o.conn, identifier, timeout)
+	if err != nil {
+		return fmt.Errorf("deleting Blue/Green Deployment: waiting for completion: %s", err)
+	}
+	return nil
+}
+
 type instanceHandler struct {
 	conn *rds_sdkv2.Client
 }
@@ -125,6 +154,10 @@ func (h *instanceHandler) createBlueGreenInput(d *schema.ResourceData) *rds_sdkv
 	input := &rds_sdkv2.CreateBlueGreenDeploymentInput{
 		BlueGreenDeploymentName: aws.String(d.Id()),
 		Source:                  aws.String(d.Get("arn").(string)),
+		Tags: []types.Tag{{
+			Key:   aws.String(blueGreenUpdateTagKey),
+			Value: aws.String(d.Id()),
+		}},
 	}
 
 	if d.HasChange("engine_version") {
@@ -156,3 +189,166 @@ func (h *instanceHandler) modifyTarget(ctx context.Context, identifier string, d
 
 	return nil
 }
+
+func (h *instanceHandler) deleteSource(ctx context.Context, identifier string, deletionProtection bool, timeout time.Duration) error {
+	if deletionProtection {
+		input := &rds_sdkv2.ModifyDBInstanceInput{
+			ApplyImmediately:     true,
+			DBInstanceIdentifier: aws.String(identifier),
+			DeletionProtection:   aws.Bool(false),
+		}
+		err := dbInstanceModify(ctx, h.conn, input, timeout)
+		if err != nil {
+			return fmt.Errorf("deleting Blue/Green Deployment source: disabling deletion protection: %s", err)
+		}
+	}
+
+	input := &rds_sdkv2.DeleteDBInstanceInput{
+		DBInstanceIdentifier: aws.String(identifier),
+		SkipFinalSnapshot:    true,
+	}
+	_, err := tfresource.RetryWhen(ctx, 5*time.Minute,
+		func() (any, error) {
+			return h.conn.DeleteDBInstance(ctx, input)
+		},
+		func(err error) (bool, error) {
+			// Retry for IAM eventual consistency.
+			apiErr, ok := errs.As[smithy.APIError](err)
+			if ok && apiErr.ErrorCode() == errCodeInvalidParameterValue && strings.Contains(apiErr.ErrorMessage(), "IAM role ARN value is invalid or does not include the required permissions") {
+				return true, err
+			}
+
+			if ok && apiErr.ErrorCode() == errCodeInvalidParameterCombination && strings.Contains(apiErr.ErrorMessage(), "disable deletion pro") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("deleting Blue/Green Deployment source: %s", err)
+	}
+	return nil
+}
+
+// resumeBlueGreenDeployment cleans up a Blue/Green Deployment left behind by an interrupted update of this DB Instance.
+// Deployments that were not created by blue_green_update for this DB Instance, or that are neither being provisioned
+// nor switched over, are left alone. It returns true if the deployment had already switched over.
+func (h *instanceHandler) resumeBlueGreenDeployment(ctx context.Context, d *schema.ResourceData, timeout time.Duration) (bool, error) {
+	dep, err := findBlueGreenDeploymentByName(ctx, h.conn, d.Id())
+	if tfresource.NotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("reading Blue/Green Deployment: %s", err)
+	}
+
+	identifier := aws.StringValue(dep.BlueGreenDeploymentIdentifier)
+	status := aws.StringValue(dep.Status)
+
+	resume, switchedOver := blueGreenDeploymentResumable(dep, d.Id())
+	if !resume {
+		log.Printf("[DEBUG] Updating RDS DB Instance (%s): Ignoring Blue/Green Deployment (%s) in status %s not left behind by this resource", d.Id(), identifier, status)
+		return false, nil
+	}
+
+	log.Printf("[DEBUG] Updating RDS DB Instance (%s): Cleaning up Blue/Green Deployment (%s) in status %s", d.Id(), identifier, status)
+
+	if switchedOver && d.Get("blue_green_update.0.delete_source").(bool) {
+		sourceARN, err := parseDBInstanceARN(aws.StringValue(dep.Source))
+		if err != nil {
+			return false, fmt.Errorf("deleting Blue/Green Deployment source: %s", err)
+		}
+
+		source, err := findDBInstanceByIDSDKv2(ctx, h.conn, sourceARN.Identifier)
+		if err == nil {
+			if aws.StringValue(source.DBInstanceStatus) != InstanceStatusDeleting {
+				if err := h.deleteSource(ctx, sourceARN.Identifier, d.Get("deletion_protection").(bool), timeout); err != nil {
+					return false, err
+				}
+			}
+		} else if !tfresource.NotFound(err) {
+			return false, fmt.Errorf("reading Blue/Green Deployment source: %s", err)
+		}
+	}
+
+	// A deployment that never switched over is discarded along with its Green environment.
+	if err := newBlueGreenOrchestrator(h.conn).deleteDeployment(ctx, identifier, !switchedOver, timeout); err != nil {
+		return false, err
+	}
+
+	return switchedOver, nil
+}
+
+// blueGreenDeploymentResumable returns whether the Blue/Green Deployment was left behind by an interrupted
+// blue_green_update of the specified DB Instance and should be cleaned up, and whether it had switched over.
+func blueGreenDeploymentResumable(dep *types.BlueGreenDeployment, id string) (bool, bool) {
+	if !blueGreenDeploymentCreatedFor(dep, id) {
+		return false, false
+	}
+
+	switch aws.StringValue(dep.Status) {
+	case "PROVISIONING", "AVAILABLE":
+		return true, false
+	case "SWITCHOVER_COMPLETED":
+		return true, true
+	default:
+		return false, false
+	}
+}
+
+// blueGreenDeploymentCreatedFor returns whether the Blue/Green Deployment was created by blue_green_update for the specified DB Instance.
+func blueGreenDeploymentCreatedFor(dep *types.BlueGreenDeployment, id string) bool {
+	for _, v := range dep.TagList {
+		if aws.StringValue(v.Key) == blueGreenUpdateTagKey && aws.StringValue(v.Value) == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// instanceBlueGreenChangesApplied returns whether every pending change to the DB Instance is already in place,
+// as is the case when an interrupted Blue/Green update had already switched over.
+// Changes that can't be compared against the DB Instance are treated as pending.
+func instanceBlueGreenChangesApplied(changedKeys []string, get func(string) interface{}, instance *rds.DBInstance) bool {
+	for _, k := range changedKeys {
+		k = strings.Split(k, ".")[0]
+
+		if slices.Contains(dbInstanceNonModifyKeys(), k) || k == "apply_immediately" {
+			continue
+		}
+
+		switch k {
+		case "allocated_storage":
+			if int64(get(k).(int)) != aws.Int64Value(instance.AllocatedStorage) {
+				return false
+			}
+		case "engine_version":
+			// The configured version may omit the minor version.
+			if v, want := aws.StringValue(instance.EngineVersion), get(k).(string); v != want && !strings.HasPrefix(v, want+".") {
+				return false
+			}
+		case "instance_class":
+			if get(k).(string) != aws.StringValue(instance.DBInstanceClass) {
+				return false
+			}
+		case "iops":
+			if int64(get(k).(int)) != aws.Int64Value(instance.Iops) {
+				return false
+			}
+		case "parameter_group_name":
+			if len(instance.DBParameterGroups) == 0 || get(k).(string) != aws.StringValue(instance.DBParameterGroups[0].DBParameterGroupName) {
+				return false
+			}
+		case "storage_type":
+			if get(k).(string) != aws.StringValue(instance.StorageType) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,203 @@
+package rds
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+func TestBlueGreenDeploymentCreatedFor(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tags     []types.Tag
+		expected bool
+	}{
+		"no tags": {
+			expected: false,
+		},
+		"other tags": {
+			tags: []types.Tag{
+				{Key: aws.String("Name"), Value: aws.String("test")},
+			},
+			expected: false,
+		},
+		"other instance": {
+			tags: []types.Tag{
+				{Key: aws.String(blueGreenUpdateTagKey), Value: aws.String("other")},
+			},
+			expected: false,
+		},
+		"this instance": {
+			tags: []types.Tag{
+				{Key: aws.String("Name"), Value: aws.String("test")},
+				{Key: aws.String(blueGreenUpdateTagKey), Value: aws.String("test")},
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dep := &types.BlueGreenDeployment{TagList: testCase.tags}
+
+			if got := blueGreenDeploymentCreatedFor(dep, "test"); got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestBlueGreenDeploymentResumable(t *testing.T) {
+	t.Parallel()
+
+	tags := []types.Tag{
+		{Key: aws.String(blueGreenUpdateTagKey), Value: aws.String("test")},
+	}
+
+	testCases := map[string]struct {
+		tags                 []types.Tag
+		status               string
+		expectedResume       bool
+		expectedSwitchedOver bool
+	}{
+		"other instance": {
+			status: "SWITCHOVER_COMPLETED",
+		},
+		"provisioning": {
+			tags:           tags,
+			status:         "PROVISIONING",
+			expectedResume: true,
+		},
+		"available": {
+			tags:           tags,
+			status:         "AVAILABLE",
+			expectedResume: true,
+		},
+		"switchover completed": {
+			tags:                 tags,
+			status:               "SWITCHOVER_COMPLETED",
+			expectedResume:       true,
+			expectedSwitchedOver: true,
+		},
+		"switchover in progress": {
+			tags:   tags,
+			status: "SWITCHOVER_IN_PROGRESS",
+		},
+		"deleting": {
+			tags:   tags,
+			status: "DELETING",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dep := &types.BlueGreenDeployment{
+				Status:  aws.String(testCase.status),
+				TagList: testCase.tags,
+			}
+
+			resume, switchedOver := blueGreenDeploymentResumable(dep, "test")
+
+			if resume != testCase.expectedResume {
+				t.Errorf("resume: got %t, expected %t", resume, testCase.expectedResume)
+			}
+
+			if switchedOver != testCase.expectedSwitchedOver {
+				t.Errorf("switchedOver: got %t, expected %t", switchedOver, testCase.expectedSwitchedOver)
+			}
+		})
+	}
+}
+
+func TestInstanceBlueGreenChangesApplied(t *testing.T) {
+	t.Parallel()
+
+	instance := &rds.DBInstance{
+		AllocatedStorage: aws.Int64(20),
+		DBInstanceClass:  aws.String("db.t3.micro"),
+		DBParameterGroups: []*rds.DBParameterGroupStatus{
+			{DBParameterGroupName: aws.String("test")},
+		},
+		EngineVersion: aws.String("8.0.32"),
+		StorageType:   aws.String("gp2"),
+	}
+
+	testCases := map[string]struct {
+		changedKeys []string
+		config      map[string]interface{}
+		expected    bool
+	}{
+		"no changes": {
+			expected: true,
+		},
+		"non-modify changes": {
+			changedKeys: []string{"apply_immediately", "blue_green_update.0.enabled", "tags.%"},
+			expected:    true,
+		},
+		"engine version applied": {
+			changedKeys: []string{"engine_version"},
+			config:      map[string]interface{}{"engine_version": "8.0.32"},
+			expected:    true,
+		},
+		"engine version prefix applied": {
+			changedKeys: []string{"engine_version"},
+			config:      map[string]interface{}{"engine_version": "8.0"},
+			expected:    true,
+		},
+		"engine version pending": {
+			changedKeys: []string{"engine_version"},
+			config:      map[string]interface{}{"engine_version": "8.0.33"},
+			expected:    false,
+		},
+		"all applied": {
+			changedKeys: []string{"allocated_storage", "instance_class", "parameter_group_name", "storage_type"},
+			config: map[string]interface{}{
+				"allocated_storage":    20,
+				"instance_class":       "db.t3.micro",
+				"parameter_group_name": "test",
+				"storage_type":         "gp2",
+			},
+			expected: true,
+		},
+		"instance class pending": {
+			changedKeys: []string{"engine_version", "instance_class"},
+			config: map[string]interface{}{
+				"engine_version": "8.0.32",
+				"instance_class": "db.t3.small",
+			},
+			expected: false,
+		},
+		"uncomparable change": {
+			changedKeys: []string{"engine_version", "monitoring_interval"},
+			config: map[string]interface{}{
+				"engine_version":      "8.0.32",
+				"monitoring_interval": 60,
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			get := func(k string) interface{} {
+				return testCase.config[k]
+			}
+
+			if got := instanceBlueGreenChangesApplied(testCase.changedKeys, get, instance); got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"log"
+	"reflect"
 	"strings"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -218,7 +221,25 @@ func ResourceClusterInstance() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// Disabling monitoring on an existing instance is allowed to leave the role in place.
+				if d.Id() != "" {
+					return nil
+				}
+
+				if v := d.GetRawConfig().GetAttr("monitoring_role_arn"); !v.IsKnown() || v.IsNull() || v.AsString() == "" {
+					return nil
+				}
+
+				if d.NewValueKnown("monitoring_interval") && d.Get("monitoring_interval").(int) == 0 {
+					return errors.New(`"monitoring_role_arn" requires "monitoring_interval" to be greater than 0`)
+				}
+
+				return nil
+			},
+		),
 	}
 }
 
@@ -441,7 +462,39 @@ func resourceClusterInstanceRead(ctx context.Context, d *schema.ResourceData, me
 func resourceClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSConn()
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	// Promotion tier changes don't require downtime, so they are applied immediately even when apply_immediately is not set.
+	// An immediate modification also applies any modifications pending for the maintenance window,
+	// so in that case the change is left to the main modification, which honours apply_immediately.
+	promotionTierUpdated := false
+	if d.HasChange("promotion_tier") && !d.Get("apply_immediately").(bool) {
+		db, err := findDBInstanceByIDSDKv1(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading RDS Cluster Instance (%s): %s", d.Id(), err)
+		}
+
+		if !dbInstanceHasPendingModifications(db) {
+			input := &rds.ModifyDBInstanceInput{
+				ApplyImmediately:     aws.Bool(true),
+				DBInstanceIdentifier: aws.String(d.Id()),
+				PromotionTier:        aws.Int64(int64(d.Get("promotion_tier").(int))),
+			}
+
+			_, err := conn.ModifyDBInstanceWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RDS Cluster Instance (%s) promotion tier: %s", d.Id(), err)
+			}
+
+			if _, err := waitDBClusterInstanceUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster Instance (%s) update: %s", d.Id(), err)
+			}
+
+			promotionTierUpdated = true
+		}
+	}
+
+	if d.HasChangesExcept("apply_immediately", "promotion_tier", "tags", "tags_all") || (d.HasChange("promotion_tier") && !promotionTierUpdated) {
 		input := &rds.ModifyDBInstanceInput{
 			ApplyImmediately:     aws.Bool(d.Get("apply_immediately").(bool)),
 			DBInstanceIdentifier: aws.String(d.Id()),
@@ -495,7 +548,7 @@ func resourceClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 			input.PreferredMaintenanceWindow = aws.String(d.Get("preferred_maintenance_window").(string))
 		}
 
-		if d.HasChange("promotion_tier") {
+		if d.HasChange("promotion_tier") && !promotionTierUpdated {
 			input.PromotionTier = aws.Int64(int64(d.Get("promotion_tier").(int)))
 		}
 
@@ -560,6 +613,11 @@ func resourceClusterInstanceDelete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// dbInstanceHasPendingModifications returns whether the instance has modifications pending for its maintenance window.
+func dbInstanceHasPendingModifications(v *rds.DBInstance) bool {
+	return v.PendingModifiedValues != nil && !reflect.ValueOf(*v.PendingModifiedValues).IsZero()
+}
+
 func clusterSetResourceDataEngineVersionFromClusterInstance(d *schema.ResourceData, c *rds.DBInstance) {
 	oldVersion := d.Get("engine_version").(string)
 	newVersion := aws.StringValue(c.EngineVersion)
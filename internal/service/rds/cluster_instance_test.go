@@ -660,6 +660,24 @@ func TestAccRDSClusterInstance_MonitoringRoleARN_removedToEnabled(t *testing.T)
 	})
 }
 
+func TestAccRDSClusterInstance_MonitoringRoleARN_withoutInterval(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterInstanceConfig_monitoringRoleARNWithoutInterval(rName),
+				ExpectError: regexp.MustCompile(`"monitoring_role_arn" requires "monitoring_interval" to be greater than 0`),
+			},
+		},
+	})
+}
+
 func TestAccRDSClusterInstance_PerformanceInsightsEnabled_auroraMySQL1(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1577,6 +1595,21 @@ resource "aws_rds_cluster_instance" "test" {
 `, rName))
 }
 
+func testAccClusterInstanceConfig_monitoringRoleARNWithoutInterval(rName string) string {
+	return acctest.ConfigCompose(testAccClusterInstanceConfig_base(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_rds_cluster_instance" "test" {
+  cluster_identifier  = aws_rds_cluster.test.id
+  identifier          = %[1]q
+  instance_class      = data.aws_rds_orderable_db_instance.test.instance_class
+  monitoring_role_arn = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/%[1]s"
+}
+`, rName))
+}
+
 func testAccClusterInstanceConfig_performanceInsightsEnabledAuroraMySQL1(rName, engine string) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
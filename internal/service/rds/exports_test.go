@@ -1,3 +1,6 @@
 package rds
 
-var FindDBInstanceByID = findDBInstanceByIDSDKv1
+var (
+	FindDBInstanceByID    = findDBInstanceByIDSDKv1
+	WaitDBInstanceDeleted = waitDBInstanceDeleted
+)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -185,6 +186,11 @@ func FindDBSnapshotByID(ctx context.Context, conn *rds.RDS, id string) (*rds.DBS
 		DBSnapshotIdentifier: aws.String(id),
 	}
 
+	// Snapshots shared from another account can only be described by ARN.
+	if arn.IsARN(id) {
+		input.IncludeShared = aws.Bool(true)
+	}
+
 	output, err := conn.DescribeDBSnapshotsWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBSnapshotNotFoundFault) {
@@ -209,7 +215,7 @@ func FindDBSnapshotByID(ctx context.Context, conn *rds.RDS, id string) (*rds.DBS
 	dbSnapshot := output.DBSnapshots[0]
 
 	// Eventual consistency check.
-	if aws.StringValue(dbSnapshot.DBSnapshotIdentifier) != id {
+	if aws.StringValue(dbSnapshot.DBSnapshotIdentifier) != id && aws.StringValue(dbSnapshot.DBSnapshotArn) != id {
 		return nil, &resource.NotFoundError{
 			LastRequest: input,
 		}
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/smithy-go"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -27,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -138,10 +140,20 @@ func ResourceInstance() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"delete_source": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
 						"enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
 						},
+						"switchover_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(30, 3600),
+						},
 					},
 				},
 			},
@@ -328,6 +340,71 @@ func ResourceInstance() *schema.Resource {
 				},
 				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
 			},
+			"manage_master_user_password": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"password"},
+			},
+			"master_user_secret": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"automatically_after_days": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rotation_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"secret_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"master_user_secret_kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"master_user_secret_rotation": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"automatically_after_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 1000),
+							ExactlyOneOf: []string{"master_user_secret_rotation.0.automatically_after_days", "master_user_secret_rotation.0.schedule_expression"},
+						},
+						"duration": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]{1,2}h$`), "must be a number of hours followed by 'h', e.g. 3h"),
+						},
+						"schedule_expression": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+							ExactlyOneOf: []string{"master_user_secret_rotation.0.automatically_after_days", "master_user_secret_rotation.0.schedule_expression"},
+						},
+					},
+				},
+			},
 			"max_allocated_storage": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -389,9 +466,10 @@ func ResourceInstance() *schema.Resource {
 				Computed: true,
 			},
 			"password": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"manage_master_user_password"},
 			},
 			"performance_insights_enabled": {
 				Type:     schema.TypeBool,
@@ -598,6 +676,18 @@ func ResourceInstance() *schema.Resource {
 				}
 				return nil
 			},
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if d.Id() == "" || !d.Get("blue_green_update.0.enabled").(bool) {
+					return nil
+				}
+
+				replicas := flex.ExpandStringValueList(d.Get("replicas").([]interface{}))
+				if len(replicas) == 0 || !dbInstanceHasModifyChanges(d.GetChangedKeysPrefix("")) {
+					return nil
+				}
+
+				return fmt.Errorf(`"blue_green_update.enabled" cannot be used to update an RDS DB Instance with read replicas (%s). Remove the read replicas or disable "blue_green_update.enabled".`, strings.Join(replicas, ", "))
+			},
 		),
 	}
 }
@@ -813,7 +903,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		if _, ok := d.GetOk("engine"); !ok {
 			diags = sdkdiag.AppendErrorf(diags, `"engine": required field is not set`)
 		}
-		if _, ok := d.GetOk("password"); !ok {
+		if _, ok := d.GetOk("password"); !ok && !d.Get("manage_master_user_password").(bool) {
 			diags = sdkdiag.AppendErrorf(diags, `"password": required field is not set`)
 		}
 		if _, ok := d.GetOk("username"); !ok {
@@ -843,7 +933,6 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			Engine:                  aws.String(d.Get("engine").(string)),
 			EngineVersion:           aws.String(d.Get("engine_version").(string)),
 			MasterUsername:          aws.String(d.Get("username").(string)),
-			MasterUserPassword:      aws.String(d.Get("password").(string)),
 			PubliclyAccessible:      aws.Bool(d.Get("publicly_accessible").(bool)),
 			S3BucketName:            aws.String(tfMap["bucket_name"].(string)),
 			S3IngestionRoleArn:      aws.String(tfMap["ingestion_role"].(string)),
@@ -878,6 +967,16 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.KmsKeyId = aws.String(v.(string))
 		}
 
+		if d.Get("manage_master_user_password").(bool) {
+			input.ManageMasterUserPassword = aws.Bool(true)
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				input.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+		} else {
+			input.MasterUserPassword = aws.String(d.Get("password").(string))
+		}
+
 		if v, ok := d.GetOk("license_model"); ok {
 			input.LicenseModel = aws.String(v.(string))
 		}
@@ -1115,6 +1214,15 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.DBParameterGroupName = aws.String(v.(string))
 		}
 
+		if d.Get("manage_master_user_password").(bool) {
+			modifyDbInstanceInput.ManageMasterUserPassword = aws.Bool(true)
+			requiresModifyDbInstance = true
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				modifyDbInstanceInput.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+		}
+
 		if v, ok := d.GetOk("password"); ok {
 			modifyDbInstanceInput.MasterUserPassword = aws.String(v.(string))
 			requiresModifyDbInstance = true
@@ -1345,7 +1453,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		if _, ok := d.GetOk("engine"); !ok {
 			diags = sdkdiag.AppendErrorf(diags, `"engine": required field is not set`)
 		}
-		if _, ok := d.GetOk("password"); !ok {
+		if _, ok := d.GetOk("password"); !ok && !d.Get("manage_master_user_password").(bool) {
 			diags = sdkdiag.AppendErrorf(diags, `"password": required field is not set`)
 		}
 		if _, ok := d.GetOk("username"); !ok {
@@ -1367,7 +1475,6 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			Engine:                  aws.String(d.Get("engine").(string)),
 			EngineVersion:           aws.String(d.Get("engine_version").(string)),
 			MasterUsername:          aws.String(d.Get("username").(string)),
-			MasterUserPassword:      aws.String(d.Get("password").(string)),
 			PubliclyAccessible:      aws.Bool(d.Get("publicly_accessible").(bool)),
 			StorageEncrypted:        aws.Bool(d.Get("storage_encrypted").(bool)),
 			Tags:                    Tags(tags.IgnoreAWS()),
@@ -1421,6 +1528,16 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.KmsKeyId = aws.String(v.(string))
 		}
 
+		if d.Get("manage_master_user_password").(bool) {
+			input.ManageMasterUserPassword = aws.Bool(true)
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				input.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+		} else {
+			input.MasterUserPassword = aws.String(d.Get("password").(string))
+		}
+
 		if v, ok := d.GetOk("license_model"); ok {
 			input.LicenseModel = aws.String(v.(string))
 		}
@@ -1560,6 +1677,12 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v, ok := d.GetOk("master_user_secret_rotation"); ok && d.Get("manage_master_user_password").(bool) {
+		if err := updateInstanceMasterUserSecretRotation(ctx, meta.(*conns.AWSClient), d.Id(), v.([]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s) master user secret rotation: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceInstanceRead(ctx, d, meta)...)
 }
 
@@ -1619,6 +1742,35 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	d.Set("license_model", v.LicenseModel)
 	d.Set("maintenance_window", v.PreferredMaintenanceWindow)
+	if v.MasterUserSecret != nil {
+		secretARN := aws.StringValue(v.MasterUserSecret.SecretArn)
+		secret, err := tfsecretsmanager.FindSecretByID(ctx, meta.(*conns.AWSClient).SecretsManagerConn(), secretARN)
+
+		// Without permission to describe the secret its rotation settings are left as they are in state.
+		secretAccessDenied := tfawserr.ErrCodeEquals(err, "AccessDeniedException")
+
+		if secretAccessDenied {
+			diags = sdkdiag.AppendWarningf(diags, "reading RDS DB Instance (%s) master user secret (%s): %s", d.Id(), secretARN, err)
+		} else if err != nil && !tfresource.NotFound(err) {
+			return sdkdiag.AppendErrorf(diags, "reading RDS DB Instance (%s) master user secret (%s): %s", d.Id(), secretARN, err)
+		}
+
+		d.Set("manage_master_user_password", true)
+		if err := d.Set("master_user_secret", []interface{}{flattenMasterUserSecret(v.MasterUserSecret, secret)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting master_user_secret: %s", err)
+		}
+		d.Set("master_user_secret_kms_key_id", v.MasterUserSecret.KmsKeyId)
+		if !secretAccessDenied {
+			if err := d.Set("master_user_secret_rotation", flattenMasterUserSecretRotation(secret)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting master_user_secret_rotation: %s", err)
+			}
+		}
+	} else {
+		d.Set("manage_master_user_password", nil)
+		d.Set("master_user_secret", nil)
+		d.Set("master_user_secret_kms_key_id", nil)
+		d.Set("master_user_secret_rotation", nil)
+	}
 	d.Set("max_allocated_storage", v.MaxAllocatedStorage)
 	d.Set("monitoring_interval", v.MonitoringInterval)
 	d.Set("monitoring_role_arn", v.MonitoringRoleArn)
@@ -1726,17 +1878,28 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	// A previous Blue/Green update may have been interrupted. Clean up its deployment before
+	// applying the pending changes. If it had already switched over, drop the changes that are in place.
+	blueGreenApplied := false
+	if d.Get("blue_green_update.0.enabled").(bool) {
+		switchedOver, err := newInstanceHandler(conn).resumeBlueGreenDeployment(ctx, d, deadline.Remaining())
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Id(), err)
+		}
+
+		if switchedOver {
+			instance, err := findDBInstanceByIDSDKv1(ctx, meta.(*conns.AWSClient).RDSConn(), d.Id())
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): reading after Blue/Green Deployment: %s", d.Id(), err)
+			}
+
+			blueGreenApplied = instanceBlueGreenChangesApplied(d.GetChangedKeysPrefix(""), d.Get, instance)
+		}
+	}
+
 	// Having allowing_major_version_upgrade by itself should not trigger ModifyDBInstance
 	// as it results in "InvalidParameterCombination: No modifications were requested".
-	if d.HasChangesExcept(
-		"allow_major_version_upgrade",
-		"blue_green_update",
-		"delete_automated_backups",
-		"final_snapshot_identifier",
-		"replicate_source_db",
-		"skip_final_snapshot",
-		"tags", "tags_all",
-	) {
+	if !blueGreenApplied && d.HasChangesExcept(dbInstanceNonModifyKeys()...) {
 		if d.Get("blue_green_update.0.enabled").(bool) {
 			orchestrator := newBlueGreenOrchestrator(conn)
 			handler := newInstanceHandler(conn)
@@ -1819,61 +1982,34 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 			log.Printf("[DEBUG] Updating RDS DB Instance (%s): Switching over Blue/Green Deployment", d.Id())
 
-			dep, err = orchestrator.switchover(ctx, aws.StringValue(dep.BlueGreenDeploymentIdentifier), deadline.Remaining())
+			dep, err = orchestrator.switchover(ctx, aws.StringValue(dep.BlueGreenDeploymentIdentifier), d.Get("blue_green_update.0.switchover_timeout").(int), deadline.Remaining())
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Id(), err)
 			}
 
-			log.Printf("[DEBUG] Updating RDS DB Instance (%s): Deleting Blue/Green Deployment source", d.Id())
+			if !d.Get("blue_green_update.0.delete_source").(bool) {
+				log.Printf("[DEBUG] Updating RDS DB Instance (%s): Keeping Blue/Green Deployment source (%s)", d.Id(), aws.StringValue(dep.Source))
+			} else {
+				log.Printf("[DEBUG] Updating RDS DB Instance (%s): Deleting Blue/Green Deployment source", d.Id())
 
-			sourceARN, err := parseDBInstanceARN(aws.StringValue(dep.Source))
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
-			}
-			if d.Get("deletion_protection").(bool) {
-				input := &rds_sdkv2.ModifyDBInstanceInput{
-					ApplyImmediately:     true,
-					DBInstanceIdentifier: aws.String(sourceARN.Identifier),
-					DeletionProtection:   aws.Bool(false),
+				sourceARN, err := parseDBInstanceARN(aws.StringValue(dep.Source))
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
 				}
-				err := dbInstanceModify(ctx, conn, input, deadline.Remaining())
+
+				err = handler.deleteSource(ctx, sourceARN.Identifier, d.Get("deletion_protection").(bool), deadline.Remaining())
 				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source: disabling deletion protection: %s", d.Id(), err)
+					return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Id(), err)
 				}
-			}
-			deleteInput := &rds_sdkv2.DeleteDBInstanceInput{
-				DBInstanceIdentifier: aws.String(sourceARN.Identifier),
-				SkipFinalSnapshot:    true,
-			}
-			_, err = tfresource.RetryWhen(ctx, 5*time.Minute,
-				func() (any, error) {
-					return conn.DeleteDBInstance(ctx, deleteInput)
-				},
-				func(err error) (bool, error) {
-					// Retry for IAM eventual consistency.
-					apiErr, ok := errs.As[smithy.APIError](err)
-					if ok && apiErr.ErrorCode() == errCodeInvalidParameterValue && strings.Contains(apiErr.ErrorMessage(), "IAM role ARN value is invalid or does not include the required permissions") {
-						return true, err
-					}
 
-					if ok && apiErr.ErrorCode() == errCodeInvalidParameterCombination && strings.Contains(apiErr.ErrorMessage(), "disable deletion pro") {
-						return true, err
+				cleaupWaiters = append(cleaupWaiters, func(optFns ...tfresource.OptionsFunc) {
+					_, err = waitDBInstanceDeleted(ctx, meta.(*conns.AWSClient).RDSConn(), sourceARN.Identifier, deadline.Remaining(), optFns...)
+					if err != nil {
+						diags = sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source: waiting for completion: %s", d.Id(), err)
 					}
-
-					return false, err
-				},
-			)
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
+				})
 			}
 
-			cleaupWaiters = append(cleaupWaiters, func(optFns ...tfresource.OptionsFunc) {
-				_, err = waitDBInstanceDeleted(ctx, meta.(*conns.AWSClient).RDSConn(), sourceARN.Identifier, deadline.Remaining(), optFns...)
-				if err != nil {
-					diags = sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source: waiting for completion: %s", d.Id(), err)
-				}
-			})
-
 			if diags.HasError() {
 				return
 			}
@@ -1905,6 +2041,12 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChanges("manage_master_user_password", "master_user_secret_rotation") && d.Get("manage_master_user_password").(bool) {
+		if err := updateInstanceMasterUserSecretRotation(ctx, meta.(*conns.AWSClient), d.Id(), d.Get("master_user_secret_rotation").([]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s) master user secret rotation: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -2050,7 +2192,18 @@ func dbInstancePopulateModify(input *rds_sdkv2.ModifyDBInstanceInput, d *schema.
 		input.OptionGroupName = aws.String(d.Get("option_group_name").(string))
 	}
 
-	if d.HasChange("password") {
+	if d.HasChanges("manage_master_user_password", "master_user_secret_kms_key_id") {
+		needsModify = true
+		input.ManageMasterUserPassword = aws.Bool(d.Get("manage_master_user_password").(bool))
+
+		if d.Get("manage_master_user_password").(bool) {
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				input.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+		}
+	}
+
+	if d.HasChange("password") && !d.Get("manage_master_user_password").(bool) {
 		needsModify = true
 		input.MasterUserPassword = aws.String(d.Get("password").(string))
 	}
@@ -2482,6 +2635,47 @@ func statusDBInstanceSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id strin
 	}
 }
 
+func findBlueGreenDeploymentByName(ctx context.Context, conn *rds_sdkv2.Client, name string) (*types.BlueGreenDeployment, error) {
+	input := &rds_sdkv2.DescribeBlueGreenDeploymentsInput{
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("blue-green-deployment-name"),
+				Values: []string{name},
+			},
+		},
+	}
+
+	output, err := conn.DescribeBlueGreenDeployments(ctx, input)
+
+	if errs.IsA[*types.BlueGreenDeploymentNotFoundFault](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.BlueGreenDeployments) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.BlueGreenDeployments); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	deployment := output.BlueGreenDeployments[0]
+
+	if aws.StringValue(deployment.BlueGreenDeploymentName) != name {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return &deployment, nil
+}
+
 func findBlueGreenDeploymentByID(ctx context.Context, conn *rds_sdkv2.Client, id string) (*types.BlueGreenDeployment, error) {
 	input := &rds_sdkv2.DescribeBlueGreenDeploymentsInput{
 		BlueGreenDeploymentIdentifier: aws.String(id),
@@ -2610,6 +2804,30 @@ func statusBlueGreenDeployment(ctx context.Context, conn *rds_sdkv2.Client, id s
 	}
 }
 
+// dbInstanceNonModifyKeys returns the arguments whose changes alone do not require ModifyDBInstance.
+func dbInstanceNonModifyKeys() []string {
+	return []string{
+		"allow_major_version_upgrade",
+		"blue_green_update",
+		"delete_automated_backups",
+		"final_snapshot_identifier",
+		"master_user_secret_rotation",
+		"replicate_source_db",
+		"skip_final_snapshot",
+		"tags", "tags_all",
+	}
+}
+
+func dbInstanceHasModifyChanges(changedKeys []string) bool {
+	for _, k := range changedKeys {
+		if !slices.Contains(dbInstanceNonModifyKeys(), strings.Split(k, ".")[0]) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func dbInstanceValidBlueGreenEngines() []string {
 	return []string{
 		InstanceEngineMariaDB,
@@ -2617,6 +2835,91 @@ func dbInstanceValidBlueGreenEngines() []string {
 	}
 }
 
+// updateInstanceMasterUserSecretRotation configures the rotation schedule of the
+// Secrets Manager secret that RDS manages for the DB instance's master user.
+func updateInstanceMasterUserSecretRotation(ctx context.Context, client *conns.AWSClient, id string, tfList []interface{}) error {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	instance, err := findDBInstanceByIDSDKv1(ctx, client.RDSConn(), id)
+
+	if err != nil {
+		return err
+	}
+
+	if instance.MasterUserSecret == nil {
+		return fmt.Errorf("master user password is not managed in Secrets Manager")
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	rotationRules := &secretsmanager.RotationRulesType{}
+
+	if v, ok := tfMap["automatically_after_days"].(int); ok && v != 0 {
+		rotationRules.AutomaticallyAfterDays = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["duration"].(string); ok && v != "" {
+		rotationRules.Duration = aws.String(v)
+	}
+
+	if v, ok := tfMap["schedule_expression"].(string); ok && v != "" {
+		rotationRules.ScheduleExpression = aws.String(v)
+	}
+
+	input := &secretsmanager.RotateSecretInput{
+		RotateImmediately: aws.Bool(false),
+		RotationRules:     rotationRules,
+		SecretId:          instance.MasterUserSecret.SecretArn,
+	}
+
+	_, err = client.SecretsManagerConn().RotateSecretWithContext(ctx, input)
+
+	return err
+}
+
+func flattenMasterUserSecret(apiObject *rds.MasterUserSecret, secret *secretsmanager.DescribeSecretOutput) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"kms_key_id":    aws.StringValue(apiObject.KmsKeyId),
+		"secret_arn":    aws.StringValue(apiObject.SecretArn),
+		"secret_status": aws.StringValue(apiObject.SecretStatus),
+	}
+
+	if secret != nil {
+		tfMap["rotation_enabled"] = aws.BoolValue(secret.RotationEnabled)
+
+		if v := secret.RotationRules; v != nil {
+			tfMap["automatically_after_days"] = aws.Int64Value(v.AutomaticallyAfterDays)
+		}
+	}
+
+	return tfMap
+}
+
+func flattenMasterUserSecretRotation(secret *secretsmanager.DescribeSecretOutput) []interface{} {
+	if secret == nil || !aws.BoolValue(secret.RotationEnabled) || secret.RotationRules == nil {
+		return nil
+	}
+
+	rules := secret.RotationRules
+	tfMap := map[string]interface{}{
+		"duration":            aws.StringValue(rules.Duration),
+		"schedule_expression": aws.StringValue(rules.ScheduleExpression),
+	}
+
+	// Only populate automatically_after_days if schedule_expression is not set,
+	// as the two are mutually exclusive in configuration.
+	if rules.ScheduleExpression == nil {
+		tfMap["automatically_after_days"] = aws.Int64Value(rules.AutomaticallyAfterDays)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenEndpoint(apiObject *rds.Endpoint) map[string]interface{} {
 	if apiObject == nil {
 		return nil
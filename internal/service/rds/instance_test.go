@@ -7,6 +7,7 @@ import (
 	"os"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -913,6 +914,91 @@ func TestAccRDSInstance_password(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_manageMasterPassword(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2, v3, v4, v5 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+	dataSourceName := "data.aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_password(rName, "valid-password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "manage_master_user_password", "false"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret.#", "0"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_manageMasterPassword(rName, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v2),
+					testAccCheckDBInstanceNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "manage_master_user_password", "true"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_user_secret.0.kms_key_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_user_secret.0.secret_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_user_secret.0.secret_status"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret.0.rotation_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret.0.automatically_after_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret_rotation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret_rotation.0.automatically_after_days", "30"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "master_user_secret.0.secret_arn", resourceName, "master_user_secret.0.secret_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"skip_final_snapshot",
+				},
+			},
+			{
+				Config: testAccInstanceConfig_manageMasterPassword(rName, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v3),
+					testAccCheckDBInstanceNotRecreated(&v2, &v3),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret.0.automatically_after_days", "60"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret_rotation.0.automatically_after_days", "60"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_manageMasterPasswordScheduleExpression(rName, "rate(10 days)", "3h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v4),
+					testAccCheckDBInstanceNotRecreated(&v3, &v4),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret_rotation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret_rotation.0.automatically_after_days", "0"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret_rotation.0.duration", "3h"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret_rotation.0.schedule_expression", "rate(10 days)"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_password(rName, "valid-password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v5),
+					testAccCheckDBInstanceNotRecreated(&v4, &v5),
+					resource.TestCheckResourceAttr(resourceName, "manage_master_user_password", "false"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_ReplicateSourceDB_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -4501,6 +4587,80 @@ func TestAccRDSInstance_BlueGreenDeployment_updateEngineVersion(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_BlueGreenDeployment_keepSource(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_keepSource(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.delete_source", "false"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.switchover_timeout", "600"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_keepSource(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v2),
+					testAccCheckDBInstanceRecreated(&v1, &v2),
+					resource.TestCheckResourceAttrPair(resourceName, "engine_version", "data.aws_rds_engine_version.updated", "version"),
+					testAccCheckInstanceBlueGreenSourceRetained(ctx, rName+"-old1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_BlueGreenDeployment_readReplica(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_readReplica(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				// Refresh so that the source instance's replicas are known.
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "replicas.#", "1"),
+				),
+			},
+			{
+				Config:      testAccInstanceConfig_BlueGreenDeployment_readReplica(rName, true),
+				ExpectError: regexp.MustCompile(`cannot be used to update an RDS DB Instance with read replicas`),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_BlueGreenDeployment_updateParameterGroup(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -5420,6 +5580,33 @@ func dbInstanceIdentity(v *rds.DBInstance) string {
 	return aws.StringValue(v.DbiResourceId)
 }
 
+// testAccCheckInstanceBlueGreenSourceRetained verifies that the Blue/Green Deployment source
+// instance was kept and then deletes it, as it is no longer managed by Terraform.
+func testAccCheckInstanceBlueGreenSourceRetained(ctx context.Context, identifier string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
+
+		if _, err := tfrds.FindDBInstanceByID(ctx, conn, identifier); err != nil {
+			return fmt.Errorf("reading retained RDS DB Instance (%s): %w", identifier, err)
+		}
+
+		_, err := conn.DeleteDBInstanceWithContext(ctx, &rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(identifier),
+			SkipFinalSnapshot:    aws.Bool(true),
+		})
+
+		if err != nil {
+			return fmt.Errorf("deleting retained RDS DB Instance (%s): %w", identifier, err)
+		}
+
+		if _, err := tfrds.WaitDBInstanceDeleted(ctx, conn, identifier, 60*time.Minute); err != nil {
+			return fmt.Errorf("waiting for retained RDS DB Instance (%s) delete: %w", identifier, err)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckInstanceExists(ctx context.Context, n string, v *rds.DBInstance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -7144,6 +7331,49 @@ resource "aws_db_instance" "test" {
 `, rName, password))
 }
 
+func testAccInstanceConfig_manageMasterPassword(rName string, days int) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_orderableClassMySQL(), fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  allocated_storage           = 5
+  apply_immediately           = true
+  engine                      = data.aws_rds_orderable_db_instance.test.engine
+  identifier                  = %[1]q
+  instance_class              = data.aws_rds_orderable_db_instance.test.instance_class
+  manage_master_user_password = true
+  username                    = "tfacctest"
+  skip_final_snapshot         = true
+
+  master_user_secret_rotation {
+    automatically_after_days = %[2]d
+  }
+}
+
+data "aws_db_instance" "test" {
+  db_instance_identifier = aws_db_instance.test.identifier
+}
+`, rName, days))
+}
+
+func testAccInstanceConfig_manageMasterPasswordScheduleExpression(rName, scheduleExpression, duration string) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_orderableClassMySQL(), fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  allocated_storage           = 5
+  apply_immediately           = true
+  engine                      = data.aws_rds_orderable_db_instance.test.engine
+  identifier                  = %[1]q
+  instance_class              = data.aws_rds_orderable_db_instance.test.instance_class
+  manage_master_user_password = true
+  username                    = "tfacctest"
+  skip_final_snapshot         = true
+
+  master_user_secret_rotation {
+    duration            = %[3]q
+    schedule_expression = %[2]q
+  }
+}
+`, rName, scheduleExpression, duration))
+}
+
 func testAccInstanceConfig_ReplicateSourceDB_basic(rName string) string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_orderableClassMySQL(),
@@ -9949,6 +10179,98 @@ resource "aws_db_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_BlueGreenDeployment_keepSource(rName string, updated bool) string {
+	return acctest.ConfigCompose(
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier              = %[1]q
+  allocated_storage       = 10
+  backup_retention_period = 1
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  engine_version          = data.aws_rds_orderable_db_instance.test.engine_version
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  db_name                 = "test"
+  parameter_group_name    = "default.${local.engine_version.parameter_group_family}"
+  skip_final_snapshot     = true
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+
+  blue_green_update {
+    enabled            = true
+    delete_source      = false
+    switchover_timeout = 600
+  }
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine         = local.engine_version.engine
+  engine_version = local.engine_version.version
+  license_model  = "general-public-license"
+  storage_type   = "standard"
+
+  preferred_instance_classes = [%[3]s]
+}
+
+data "aws_rds_engine_version" "initial" {
+  engine             = "mysql"
+  preferred_versions = ["8.0.27", "8.0.26", "8.0.25"]
+}
+
+data "aws_rds_engine_version" "updated" {
+  engine             = data.aws_rds_engine_version.initial.engine
+  preferred_versions = data.aws_rds_engine_version.initial.valid_upgrade_targets
+}
+
+locals {
+  engine_version = %[2]t ? data.aws_rds_engine_version.updated : data.aws_rds_engine_version.initial
+}
+`, rName, updated, mySQLPreferredInstanceClasses))
+}
+
+func testAccInstanceConfig_BlueGreenDeployment_readReplica(rName string, updated bool) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMySQL(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier              = %[1]q
+  allocated_storage       = 10
+  backup_retention_period = 1
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  engine_version          = data.aws_rds_orderable_db_instance.test.engine_version
+  instance_class          = %[2]t ? data.aws_rds_orderable_db_instance.updated.instance_class : data.aws_rds_orderable_db_instance.test.instance_class
+  db_name                 = "test"
+  parameter_group_name    = "default.${data.aws_rds_engine_version.default.parameter_group_family}"
+  skip_final_snapshot     = true
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+
+  blue_green_update {
+    enabled = true
+  }
+}
+
+resource "aws_db_instance" "replica" {
+  identifier          = "%[1]s-replica"
+  instance_class      = aws_db_instance.test.instance_class
+  replicate_source_db = aws_db_instance.test.identifier
+  skip_final_snapshot = true
+
+  lifecycle {
+    ignore_changes = [instance_class]
+  }
+}
+
+data "aws_rds_orderable_db_instance" "updated" {
+  engine         = data.aws_rds_engine_version.default.engine
+  engine_version = data.aws_rds_engine_version.default.version
+  license_model  = "general-public-license"
+  storage_type   = "standard"
+
+  preferred_instance_classes = ["db.t4g.micro", "db.t4g.small"]
+}
+`, rName, updated))
+}
+
 func testAccInstanceConfig_BlueGreenDeployment_parameterGroup(rName string) string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_orderableClassMySQL(),
@@ -1,7 +1,9 @@
 package rds
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -42,13 +45,15 @@ func ResourceProxy() *schema.Resource {
 				Computed: true,
 			},
 			"auth": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Required: true,
+				Set:      proxyAuthHash,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"auth_scheme": {
 							Type:         schema.TypeString,
 							Optional:     true,
+							Default:      rds.AuthSchemeSecrets,
 							ValidateFunc: validation.StringInSlice(rds.AuthScheme_Values(), false),
 						},
 						"client_password_auth_type": {
@@ -64,6 +69,7 @@ func ResourceProxy() *schema.Resource {
 						"iam_auth": {
 							Type:         schema.TypeString,
 							Optional:     true,
+							Default:      rds.IAMAuthModeDisabled,
 							ValidateFunc: validation.StringInSlice(rds.IAMAuthMode_Values(), false),
 						},
 						"secret_arn": {
@@ -140,7 +146,7 @@ func resourceProxyCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	input := rds.CreateDBProxyInput{
-		Auth:         expandProxyAuth(d.Get("auth").([]interface{})),
+		Auth:         expandProxyAuth(d.Get("auth").(*schema.Set).List()),
 		DBProxyName:  aws.String(d.Get("name").(string)),
 		EngineFamily: aws.String(d.Get("engine_family").(string)),
 		RoleArn:      aws.String(d.Get("role_arn").(string)),
@@ -236,7 +242,7 @@ func resourceProxyUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	if d.HasChangesExcept("tags", "tags_all") {
 		oName, nName := d.GetChange("name")
 		input := &rds.ModifyDBProxyInput{
-			Auth:           expandProxyAuth(d.Get("auth").([]interface{})),
+			Auth:           expandProxyAuth(d.Get("auth").(*schema.Set).List()),
 			DBProxyName:    aws.String(oName.(string)),
 			DebugLogging:   aws.Bool(d.Get("debug_logging").(bool)),
 			NewDBProxyName: aws.String(nName.(string)),
@@ -348,6 +354,31 @@ func expandProxyAuth(l []interface{}) []*rds.UserAuthConfig {
 	return userAuthConfigs
 }
 
+// proxyAuthHash identifies an auth entry by its user, secret and
+// authentication modes. The description and the client password
+// authentication type (which AWS populates from the engine family when it
+// isn't configured) are left out so that they diff in place.
+func proxyAuthHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	authScheme, _ := m["auth_scheme"].(string)
+	if authScheme == "" {
+		authScheme = rds.AuthSchemeSecrets
+	}
+	iamAuth, _ := m["iam_auth"].(string)
+	if iamAuth == "" {
+		iamAuth = rds.IAMAuthModeDisabled
+	}
+
+	buf.WriteString(fmt.Sprintf("%s-", authScheme))
+	buf.WriteString(fmt.Sprintf("%s-", iamAuth))
+	buf.WriteString(fmt.Sprintf("%s-", m["secret_arn"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["username"].(string)))
+
+	return create.StringHashcode(buf.String())
+}
+
 func flattenProxyAuth(userAuthConfig *rds.UserAuthConfigInfo) map[string]interface{} {
 	m := make(map[string]interface{})
 
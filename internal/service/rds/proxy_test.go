@@ -41,10 +41,12 @@ func TestAccRDSProxy_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "engine_family", "MYSQL"),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rds", regexp.MustCompile(`db-proxy:.+`)),
 					resource.TestCheckResourceAttr(resourceName, "auth.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "auth.0.auth_scheme", "SECRETS"),
-					resource.TestCheckResourceAttr(resourceName, "auth.0.description", "test"),
-					resource.TestCheckResourceAttr(resourceName, "auth.0.iam_auth", "DISABLED"),
-					resource.TestCheckResourceAttr(resourceName, "auth.0.client_password_auth_type", "MYSQL_NATIVE_PASSWORD"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auth.*", map[string]string{
+						"auth_scheme":               "SECRETS",
+						"client_password_auth_type": "MYSQL_NATIVE_PASSWORD",
+						"description":               "test",
+						"iam_auth":                  "DISABLED",
+					}),
 					resource.TestCheckResourceAttr(resourceName, "debug_logging", "false"),
 					resource.TestCheckResourceAttr(resourceName, "idle_client_timeout", "1800"),
 					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
@@ -323,7 +325,9 @@ func TestAccRDSProxy_authDescription(t *testing.T) {
 				Config: testAccProxyConfig_name(rName, rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckProxyExists(ctx, resourceName, &dbProxy),
-					resource.TestCheckResourceAttr(resourceName, "auth.0.description", "test"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auth.*", map[string]string{
+						"description": "test",
+					}),
 				),
 			},
 			{
@@ -335,7 +339,9 @@ func TestAccRDSProxy_authDescription(t *testing.T) {
 				Config: testAccProxyConfig_authDescription(rName, description),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckProxyExists(ctx, resourceName, &dbProxy),
-					resource.TestCheckResourceAttr(resourceName, "auth.0.description", description),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auth.*", map[string]string{
+						"description": description,
+					}),
 				),
 			},
 		},
@@ -363,7 +369,9 @@ func TestAccRDSProxy_authIAMAuth(t *testing.T) {
 				Config: testAccProxyConfig_name(rName, rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckProxyExists(ctx, resourceName, &dbProxy),
-					resource.TestCheckResourceAttr(resourceName, "auth.0.iam_auth", "DISABLED"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auth.*", map[string]string{
+						"iam_auth": "DISABLED",
+					}),
 				),
 			},
 			{
@@ -375,7 +383,9 @@ func TestAccRDSProxy_authIAMAuth(t *testing.T) {
 				Config: testAccProxyConfig_authIAMAuth(rName, iamAuth),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckProxyExists(ctx, resourceName, &dbProxy),
-					resource.TestCheckResourceAttr(resourceName, "auth.0.iam_auth", iamAuth),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auth.*", map[string]string{
+						"iam_auth": iamAuth,
+					}),
 				),
 			},
 		},
@@ -403,7 +413,7 @@ func TestAccRDSProxy_authSecretARN(t *testing.T) {
 				Config: testAccProxyConfig_name(rName, rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckProxyExists(ctx, resourceName, &dbProxy),
-					resource.TestCheckResourceAttrPair(resourceName, "auth.0.secret_arn", "aws_secretsmanager_secret.test", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "auth.*.secret_arn", "aws_secretsmanager_secret.test", "arn"),
 				),
 			},
 			{
@@ -415,7 +425,7 @@ func TestAccRDSProxy_authSecretARN(t *testing.T) {
 				Config: testAccProxyConfig_authSecretARN(rName, nName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckProxyExists(ctx, resourceName, &dbProxy),
-					resource.TestCheckResourceAttrPair(resourceName, "auth.0.secret_arn", "aws_secretsmanager_secret.test2", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "auth.*.secret_arn", "aws_secretsmanager_secret.test2", "arn"),
 				),
 			},
 		},
@@ -442,7 +452,69 @@ func TestAccRDSProxy_authUsername(t *testing.T) {
 				Config: testAccProxyConfig_name(rName, rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckProxyExists(ctx, resourceName, &dbProxy),
-					resource.TestCheckResourceAttr(resourceName, "auth.0.username", ""),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auth.*", map[string]string{
+						"username": "",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSProxy_authMultipleUsers(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbProxy rds.DBProxy
+	resourceName := "aws_db_proxy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	nName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccDBProxyPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProxyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProxyConfig_authMultipleUsers(rName, nName, false, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProxyExists(ctx, resourceName, &dbProxy),
+					resource.TestCheckResourceAttr(resourceName, "auth.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auth.*", map[string]string{
+						"description": "test1",
+						"iam_auth":    "DISABLED",
+						"username":    "db_user1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auth.*", map[string]string{
+						"description": "test2",
+						"iam_auth":    "DISABLED",
+						"username":    "db_user2",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "auth.*.secret_arn", "aws_secretsmanager_secret.test", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "auth.*.secret_arn", "aws_secretsmanager_secret.test2", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccProxyConfig_authMultipleUsers(rName, nName, true, "test2"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccProxyConfig_authMultipleUsers(rName, nName, false, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProxyExists(ctx, resourceName, &dbProxy),
+					resource.TestCheckResourceAttr(resourceName, "auth.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auth.*", map[string]string{
+						"description": "updated",
+						"username":    "db_user2",
+					}),
 				),
 			},
 		},
@@ -947,6 +1019,54 @@ resource "aws_secretsmanager_secret_version" "test2" {
 `, rName, nName)
 }
 
+func testAccProxyConfig_authMultipleUsers(rName, nName string, reversed bool, description string) string {
+	auth1 := `
+  auth {
+    description = "test1"
+    secret_arn  = aws_secretsmanager_secret.test.arn
+    username    = "db_user1"
+  }
+`
+	auth2 := fmt.Sprintf(`
+  auth {
+    description = %[1]q
+    secret_arn  = aws_secretsmanager_secret.test2.arn
+    username    = "db_user2"
+  }
+`, description)
+
+	auths := auth1 + auth2
+	if reversed {
+		auths = auth2 + auth1
+	}
+
+	return testAccProxyBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_db_proxy" "test" {
+  depends_on = [
+    aws_secretsmanager_secret_version.test,
+    aws_secretsmanager_secret_version.test2,
+    aws_iam_role_policy.test
+  ]
+
+  name                   = "%[1]s"
+  engine_family          = "MYSQL"
+  role_arn               = aws_iam_role.test.arn
+  vpc_security_group_ids = [aws_security_group.test.id]
+  vpc_subnet_ids         = aws_subnet.test[*].id
+%[3]s}
+
+resource "aws_secretsmanager_secret" "test2" {
+  name                    = "%[2]s"
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "test2" {
+  secret_id     = aws_secretsmanager_secret.test2.id
+  secret_string = "{\"username\":\"db_user2\",\"password\":\"db_user2_password\"}"
+}
+`, rName, nName, auths)
+}
+
 func testAccProxyConfig_tags(rName, key, value string) string {
 	return testAccProxyBaseConfig(rName) + fmt.Sprintf(`
 resource "aws_db_proxy" "test" {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -98,10 +100,16 @@ func ResourceSnapshotCopy() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"shared_accounts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"source_db_snapshot_identifier": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validSnapshotCopySourceIdentifier,
 			},
 			"source_region": {
 				Type:     schema.TypeString,
@@ -174,6 +182,10 @@ func resourceSnapshotCopyCreate(ctx context.Context, d *schema.ResourceData, met
 		input.PreSignedUrl = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("target_custom_availability_zone"); ok {
+		input.TargetCustomAvailabilityZone = aws.String(v.(string))
+	}
+
 	output, err := conn.CopyDBSnapshotWithContext(ctx, input)
 
 	if err != nil {
@@ -186,6 +198,20 @@ func resourceSnapshotCopyCreate(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Snapshot Copy (%s) create: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("shared_accounts"); ok && v.(*schema.Set).Len() > 0 {
+		input := &rds.ModifyDBSnapshotAttributeInput{
+			AttributeName:        aws.String("restore"),
+			DBSnapshotIdentifier: aws.String(d.Id()),
+			ValuesToAdd:          flex.ExpandStringSet(v.(*schema.Set)),
+		}
+
+		_, err := conn.ModifyDBSnapshotAttributeWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying RDS DB Snapshot Copy (%s) attribute: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceSnapshotCopyRead(ctx, d, meta)...)
 }
 
@@ -243,6 +269,22 @@ func resourceSnapshotCopyRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
 	}
 
+	input := &rds.DescribeDBSnapshotAttributesInput{
+		DBSnapshotIdentifier: aws.String(d.Id()),
+	}
+
+	output, err := conn.DescribeDBSnapshotAttributesWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS DB Snapshot Copy (%s) attribute: %s", d.Id(), err)
+	}
+
+	if output.DBSnapshotAttributesResult != nil && len(output.DBSnapshotAttributesResult.DBSnapshotAttributes) > 0 {
+		d.Set("shared_accounts", flex.FlattenStringSet(output.DBSnapshotAttributesResult.DBSnapshotAttributes[0].AttributeValues))
+	} else {
+		d.Set("shared_accounts", nil)
+	}
+
 	return diags
 }
 
@@ -250,15 +292,34 @@ func resourceSnapshotCopyUpdate(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSConn()
 
+	if d.HasChange("shared_accounts") {
+		o, n := d.GetChange("shared_accounts")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		input := &rds.ModifyDBSnapshotAttributeInput{
+			AttributeName:        aws.String("restore"),
+			DBSnapshotIdentifier: aws.String(d.Id()),
+			ValuesToAdd:          flex.ExpandStringSet(ns.Difference(os)),
+			ValuesToRemove:       flex.ExpandStringSet(os.Difference(ns)),
+		}
+
+		_, err := conn.ModifyDBSnapshotAttributeWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying RDS DB Snapshot Copy (%s) attribute: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(ctx, conn, d.Get("db_snapshot_arn").(string), o, n); err != nil {
-			sdkdiag.AppendErrorf(diags, "updating RDS DB Snapshot Copy (%s) tags: %s", d.Get("db_snapshot_arn").(string), err)
+			return sdkdiag.AppendErrorf(diags, "updating RDS DB Snapshot Copy (%s) tags: %s", d.Get("db_snapshot_arn").(string), err)
 		}
 	}
 
-	return resourceSnapshotCopyRead(ctx, d, meta)
+	return append(diags, resourceSnapshotCopyRead(ctx, d, meta)...)
 }
 
 func resourceSnapshotCopyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -280,3 +341,14 @@ func resourceSnapshotCopyDelete(ctx context.Context, d *schema.ResourceData, met
 
 	return diags
 }
+
+// validSnapshotCopySourceIdentifier accepts either a DB snapshot identifier in
+// the current account and Region, e.g. the automated snapshot "rds:mydb-2023-01-01-00-00",
+// or, for shared and cross-Region snapshots, the snapshot's full ARN.
+func validSnapshotCopySourceIdentifier(v interface{}, k string) (ws []string, errors []error) {
+	if value := v.(string); strings.HasPrefix(value, "arn:") {
+		return verify.ValidARN(v, k)
+	}
+
+	return nil, nil
+}
@@ -223,3 +223,31 @@ func TestValidSubnetGroupNamePrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidSnapshotCopySourceIdentifier(t *testing.T) {
+	t.Parallel()
+
+	validIdentifiers := []string{
+		"mydb-snapshot",
+		"rds:mydb-2023-01-01-00-00",
+		"arn:aws:rds:us-west-2:123456789012:snapshot:mydb-snapshot",
+		"arn:aws:rds:us-west-2:123456789012:snapshot:rds:mydb-2023-01-01-00-00",
+	}
+	for _, v := range validIdentifiers {
+		_, errors := validSnapshotCopySourceIdentifier(v, "source_db_snapshot_identifier")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid RDS Snapshot Copy source identifier: %q", v, errors)
+		}
+	}
+
+	invalidIdentifiers := []string{
+		"arn:aws",
+		"arn:aws:rds",
+	}
+	for _, v := range invalidIdentifiers {
+		_, errors := validSnapshotCopySourceIdentifier(v, "source_db_snapshot_identifier")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid RDS Snapshot Copy source identifier", v)
+		}
+	}
+}
@@ -31,6 +31,11 @@ const (
 	zoneChangeSyncMaxDelay        = 30
 	zoneChangeSyncMinPollInterval = 15
 	zoneChangeSyncMaxPollInterval = 30
+
+	// Maximum number of changes, and of resource record elements, in a single ChangeResourceRecordSets request.
+	changeResourceRecordSetsMaxChanges = 1000
+	// Maximum number of characters across all Value elements in a single ChangeResourceRecordSets request.
+	changeResourceRecordSetsMaxValueLength = 32000
 )
 
 // @SDKResource("aws_route53_zone")
@@ -62,6 +67,10 @@ func ResourceZone() *schema.Resource {
 				ConflictsWith: []string{"vpc"},
 				ValidateFunc:  validation.StringLenBetween(0, 32),
 			},
+			"dnssec_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"force_destroy": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -240,6 +249,26 @@ func resourceZoneRead(ctx context.Context, d *schema.ResourceData, meta interfac
 		return sdkdiag.AppendErrorf(diags, "setting vpc: %s", err)
 	}
 
+	if output.HostedZone.Config != nil && aws.BoolValue(output.HostedZone.Config.PrivateZone) {
+		// DNSSEC signing is not supported for private hosted zones.
+		d.Set("dnssec_status", "NOT_SIGNING")
+	} else {
+		dnssec, err := FindHostedZoneDNSSEC(ctx, conn, d.Id())
+
+		switch {
+		// Don't fail reads for partitions without DNSSEC or for callers without route53:GetDNSSEC.
+		case verify.ErrorISOUnsupported(conn.PartitionID, err), tfawserr.ErrCodeEquals(err, verify.ErrCodeAccessDenied):
+			log.Printf("[WARN] reading Route53 Hosted Zone (%s) DNSSEC: %s", d.Id(), err)
+			d.Set("dnssec_status", nil)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading Route53 Hosted Zone (%s) DNSSEC: %s", d.Id(), err)
+		case dnssec != nil && dnssec.Status != nil:
+			d.Set("dnssec_status", dnssec.Status.ServeSignature)
+		default:
+			d.Set("dnssec_status", nil)
+		}
+	}
+
 	tags, err := ListTags(ctx, conn, d.Id(), route53.TagResourceTypeHostedzone)
 
 	if err != nil {
@@ -365,62 +394,96 @@ func deleteAllRecordsInHostedZoneId(ctx context.Context, hostedZoneId, hostedZon
 	input := &route53.ListResourceRecordSetsInput{
 		HostedZoneId: aws.String(hostedZoneId),
 	}
+	var changes []*route53.Change
 
-	var lastDeleteErr, lastErrorFromWaiter error
-	var pageNum = 0
 	err := conn.ListResourceRecordSetsPagesWithContext(ctx, input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
-		sets := page.ResourceRecordSets
-		pageNum += 1
+		if page == nil {
+			return !lastPage
+		}
 
-		changes := make([]*route53.Change, 0)
-		// 100 items per page returned by default
-		for _, set := range sets {
+		for _, set := range page.ResourceRecordSets {
 			if strings.TrimSuffix(aws.StringValue(set.Name), ".") == strings.TrimSuffix(hostedZoneName, ".") && (aws.StringValue(set.Type) == "NS" || aws.StringValue(set.Type) == "SOA") {
 				// Zone NS & SOA records cannot be deleted
 				continue
 			}
+
 			changes = append(changes, &route53.Change{
 				Action:            aws.String(route53.ChangeActionDelete),
 				ResourceRecordSet: set,
 			})
 		}
 
-		if len(changes) == 0 {
-			return !lastPage
-		}
+		return !lastPage
+	})
 
-		log.Printf("[DEBUG] Deleting %d records (page %d) from %s", len(changes), pageNum, hostedZoneId)
+	if err != nil {
+		return fmt.Errorf("listing record sets: %w", err)
+	}
+
+	// Delete only once all record sets have been listed, so that the pagination markers remain valid.
+	for i, batch := range batchRecordSetChanges(changes, changeResourceRecordSetsMaxChanges, changeResourceRecordSetsMaxValueLength) {
+		log.Printf("[DEBUG] Deleting %d records (batch %d) from %s", len(batch), i+1, hostedZoneId)
 
-		req := &route53.ChangeResourceRecordSetsInput{
+		input := &route53.ChangeResourceRecordSetsInput{
 			HostedZoneId: aws.String(hostedZoneId),
 			ChangeBatch: &route53.ChangeBatch{
 				Comment: aws.String("Deleted by Terraform"),
-				Changes: changes,
+				Changes: batch,
 			},
 		}
 
-		var resp interface{}
-		resp, lastDeleteErr = DeleteRecordSet(ctx, conn, req)
-		if out, ok := resp.(*route53.ChangeResourceRecordSetsOutput); ok {
-			log.Printf("[DEBUG] Waiting for change batch to become INSYNC: %#v", out)
-			if out.ChangeInfo != nil && out.ChangeInfo.Id != nil {
-				lastErrorFromWaiter = WaitForRecordSetToSync(ctx, conn, CleanChangeID(aws.StringValue(out.ChangeInfo.Id)))
-			} else {
-				log.Printf("[DEBUG] Change info was empty")
+		outputRaw, err := DeleteRecordSet(ctx, conn, input)
+
+		if err != nil {
+			return fmt.Errorf("deleting record sets: %w", err)
+		}
+
+		if output, ok := outputRaw.(*route53.ChangeResourceRecordSetsOutput); ok && output != nil && output.ChangeInfo != nil && output.ChangeInfo.Id != nil {
+			if err := WaitForRecordSetToSync(ctx, conn, CleanChangeID(aws.StringValue(output.ChangeInfo.Id))); err != nil {
+				return fmt.Errorf("waiting for record sets delete: %w", err)
 			}
-		} else {
-			log.Printf("[DEBUG] Unable to wait for change batch because of an error: %s", lastDeleteErr)
 		}
+	}
 
-		return !lastPage
-	})
+	return nil
+}
 
-	if err != nil {
-		return fmt.Errorf("Failed listing/deleting record sets: %s\nLast error from deletion: %s\nLast error from waiter: %s",
-			err, lastDeleteErr, lastErrorFromWaiter)
+// batchRecordSetChanges splits changes into batches containing at most maxChanges changes,
+// at most maxChanges resource record elements and at most maxValueLength characters across all Value elements.
+func batchRecordSetChanges(changes []*route53.Change, maxChanges, maxValueLength int) [][]*route53.Change {
+	var batches [][]*route53.Change
+	var batch []*route53.Change
+	var records, valueLength int
+
+	for _, change := range changes {
+		n, l := 1, 0
+		if change.ResourceRecordSet != nil {
+			if len(change.ResourceRecordSet.ResourceRecords) > 1 {
+				n = len(change.ResourceRecordSet.ResourceRecords)
+			}
+
+			for _, v := range change.ResourceRecordSet.ResourceRecords {
+				l += len(aws.StringValue(v.Value))
+			}
+		}
+
+		if len(batch) > 0 && (len(batch) == maxChanges || records+n > maxChanges || valueLength+l > maxValueLength) {
+			batches = append(batches, batch)
+			batch = nil
+			records = 0
+			valueLength = 0
+		}
+
+		batch = append(batch, change)
+		records += n
+		valueLength += l
 	}
 
-	return nil
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
 }
 
 func dnsSECStatus(ctx context.Context, conn *route53.Route53, hostedZoneID string) (string, error) {
@@ -0,0 +1,118 @@
+package route53
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+func TestBatchRecordSetChanges(t *testing.T) {
+	t.Parallel()
+
+	change := func(records int, value string) *route53.Change {
+		set := &route53.ResourceRecordSet{
+			Name: aws.String("test.example.com"),
+			Type: aws.String(route53.RRTypeA),
+		}
+
+		for i := 0; i < records; i++ {
+			set.ResourceRecords = append(set.ResourceRecords, &route53.ResourceRecord{Value: aws.String(value)})
+		}
+
+		return &route53.Change{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: set,
+		}
+	}
+	changes := func(n, records int) []*route53.Change {
+		var result []*route53.Change
+		for i := 0; i < n; i++ {
+			result = append(result, change(records, "127.0.0.1"))
+		}
+		return result
+	}
+	txtChanges := func(n, records, length int) []*route53.Change {
+		var result []*route53.Change
+		for i := 0; i < n; i++ {
+			result = append(result, change(records, strings.Repeat("a", length)))
+		}
+		return result
+	}
+
+	testCases := []struct {
+		Name            string
+		Changes         []*route53.Change
+		MaxChanges      int
+		MaxValueLength  int
+		ExpectedBatches []int
+	}{
+		{
+			Name:           "no changes",
+			MaxChanges:     1000,
+			MaxValueLength: 32000,
+		},
+		{
+			Name:            "single batch",
+			Changes:         changes(999, 1),
+			MaxChanges:      1000,
+			MaxValueLength:  32000,
+			ExpectedBatches: []int{999},
+		},
+		{
+			Name:            "full batches",
+			Changes:         changes(2500, 1),
+			MaxChanges:      1000,
+			MaxValueLength:  32000,
+			ExpectedBatches: []int{1000, 1000, 500},
+		},
+		{
+			Name:            "resource record limit",
+			Changes:         changes(5, 3),
+			MaxChanges:      10,
+			MaxValueLength:  32000,
+			ExpectedBatches: []int{3, 2},
+		},
+		{
+			Name:            "alias records",
+			Changes:         changes(5, 0),
+			MaxChanges:      2,
+			MaxValueLength:  32000,
+			ExpectedBatches: []int{2, 2, 1},
+		},
+		{
+			Name:            "value length limit",
+			Changes:         txtChanges(10, 2, 2000),
+			MaxChanges:      1000,
+			MaxValueLength:  32000,
+			ExpectedBatches: []int{8, 2},
+		},
+		{
+			Name:            "value length limit exceeded by single change",
+			Changes:         txtChanges(2, 1, 40000),
+			MaxChanges:      1000,
+			MaxValueLength:  32000,
+			ExpectedBatches: []int{1, 1},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := batchRecordSetChanges(testCase.Changes, testCase.MaxChanges, testCase.MaxValueLength)
+
+			if len(got) != len(testCase.ExpectedBatches) {
+				t.Fatalf("got %d batches, expected %d", len(got), len(testCase.ExpectedBatches))
+			}
+
+			for i, batch := range got {
+				if len(batch) != testCase.ExpectedBatches[i] {
+					t.Errorf("batch %d: got %d changes, expected %d", i, len(batch), testCase.ExpectedBatches[i])
+				}
+			}
+		})
+	}
+}
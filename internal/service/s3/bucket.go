@@ -32,10 +32,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
 	resNameBucket = "Bucket"
+
+	// bucketReadConcurrency is the maximum number of concurrent S3 API calls made when reading an S3 Bucket.
+	bucketReadConcurrency = 4
 )
 
 // @SDKResource("aws_s3_bucket")
@@ -976,12 +980,128 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.Set("bucket_domain_name", meta.(*conns.AWSClient).PartitionHostname(fmt.Sprintf("%s.s3", d.Get("bucket").(string))))
 
-	// Read the policy if configured outside this resource e.g. with aws_s3_bucket_policy resource
-	pol, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, error) {
+	// Read the bucket's sub-resources concurrently.
+	// The results are processed in a fixed order below.
+	bucket, timeout := d.Id(), d.Timeout(schema.TimeoutRead)
+	newCall := func(f func(context.Context) (interface{}, error)) *bucketReadCall {
+		return &bucketReadCall{f: func(ctx context.Context) (interface{}, error) {
+			return tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+				return f(ctx)
+			}, s3.ErrCodeNoSuchBucket)
+		}}
+	}
+	policyCall := newCall(func(ctx context.Context) (interface{}, error) {
 		return conn.GetBucketPolicyWithContext(ctx, &s3.GetBucketPolicyInput{
-			Bucket: aws.String(d.Id()),
+			Bucket: aws.String(bucket),
 		})
-	}, s3.ErrCodeNoSuchBucket)
+	})
+	aclCall := newCall(func(ctx context.Context) (interface{}, error) {
+		return conn.GetBucketAclWithContext(ctx, &s3.GetBucketAclInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+	corsCall := newCall(func(ctx context.Context) (interface{}, error) {
+		return conn.GetBucketCorsWithContext(ctx, &s3.GetBucketCorsInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+	websiteCall := newCall(func(ctx context.Context) (interface{}, error) {
+		return conn.GetBucketWebsiteWithContext(ctx, &s3.GetBucketWebsiteInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+	versioningCall := newCall(func(ctx context.Context) (interface{}, error) {
+		return conn.GetBucketVersioningWithContext(ctx, &s3.GetBucketVersioningInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+	accelerateCall := newCall(func(ctx context.Context) (interface{}, error) {
+		return conn.GetBucketAccelerateConfigurationWithContext(ctx, &s3.GetBucketAccelerateConfigurationInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+	payerCall := newCall(func(ctx context.Context) (interface{}, error) {
+		return conn.GetBucketRequestPaymentWithContext(ctx, &s3.GetBucketRequestPaymentInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+	loggingCall := newCall(func(ctx context.Context) (interface{}, error) {
+		return conn.GetBucketLoggingWithContext(ctx, &s3.GetBucketLoggingInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+	lifecycleCall := newCall(func(ctx context.Context) (interface{}, error) {
+		return conn.GetBucketLifecycleConfigurationWithContext(ctx, &s3.GetBucketLifecycleConfigurationInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+	replicationCall := newCall(func(ctx context.Context) (interface{}, error) {
+		return conn.GetBucketReplicationWithContext(ctx, &s3.GetBucketReplicationInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+	encryptionCall := newCall(func(ctx context.Context) (interface{}, error) {
+		return conn.GetBucketEncryptionWithContext(ctx, &s3.GetBucketEncryptionInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+	objectLockCall := newCall(func(ctx context.Context) (interface{}, error) {
+		return conn.GetObjectLockConfigurationWithContext(ctx, &s3.GetObjectLockConfigurationInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+	regionCall := &bucketReadCall{f: func(ctx context.Context) (interface{}, error) {
+		return tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+			return s3manager.GetBucketRegionWithClient(ctx, conn, bucket, func(r *request.Request) {
+				// By default, GetBucketRegion forces virtual host addressing, which
+				// is not compatible with many non-AWS implementations. Instead, pass
+				// the provider s3_force_path_style configuration, which defaults to
+				// false, but allows override.
+				r.Config.S3ForcePathStyle = conn.Config.S3ForcePathStyle
+
+				// By default, GetBucketRegion uses anonymous credentials when doing
+				// a HEAD request to get the bucket region. This breaks in aws-cn regions
+				// when the account doesn't have an ICP license to host public content.
+				// Use the current credentials when getting the bucket region.
+				r.Config.Credentials = conn.Config.Credentials
+			})
+		}, "NotFound")
+	}}
+
+	// Retry due to S3 eventual consistency
+	tagsCall := newCall(func(ctx context.Context) (interface{}, error) {
+		return BucketListTags(ctx, conn, bucket)
+	})
+
+	err = runBucketReadCalls(ctx, bucketReadConcurrency,
+		policyCall,
+		aclCall,
+		corsCall,
+		websiteCall,
+		versioningCall,
+		accelerateCall,
+		payerCall,
+		loggingCall,
+		lifecycleCall,
+		replicationCall,
+		encryptionCall,
+		objectLockCall,
+		regionCall,
+		tagsCall,
+	)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s): %s", d.Id(), err)
+	}
+
+	// Read the policy if configured outside this resource e.g. with aws_s3_bucket_policy resource
+	pol, err := policyCall.output, policyCall.err
 
 	// The call to HeadBucket above can occasionally return no error (i.e. NoSuchBucket)
 	// after a bucket has been deleted (eventual consistency woes :/), thus, when making extra S3 API calls
@@ -1009,11 +1129,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	// Read the Grant ACL.
 	// In the event grants are not configured on the bucket, the API returns an empty array
-	apResponse, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, error) {
-		return conn.GetBucketAclWithContext(ctx, &s3.GetBucketAclInput{
-			Bucket: aws.String(d.Id()),
-		})
-	}, s3.ErrCodeNoSuchBucket)
+	apResponse, err := aclCall.output, aclCall.err
 
 	// The S3 API method calls above can occasionally return no error (i.e. NoSuchBucket)
 	// after a bucket has been deleted (eventual consistency woes :/), thus, when making extra S3 API calls
@@ -1037,11 +1153,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	// Read the CORS
-	corsResponse, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, error) {
-		return conn.GetBucketCorsWithContext(ctx, &s3.GetBucketCorsInput{
-			Bucket: aws.String(d.Id()),
-		})
-	}, s3.ErrCodeNoSuchBucket)
+	corsResponse, err := corsCall.output, corsCall.err
 
 	// The S3 API method calls above can occasionally return no error (i.e. NoSuchBucket)
 	// after a bucket has been deleted (eventual consistency woes :/), thus, when making extra S3 API calls
@@ -1065,11 +1177,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	// Read the website configuration
-	wsResponse, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, error) {
-		return conn.GetBucketWebsiteWithContext(ctx, &s3.GetBucketWebsiteInput{
-			Bucket: aws.String(d.Id()),
-		})
-	}, s3.ErrCodeNoSuchBucket)
+	wsResponse, err := websiteCall.output, websiteCall.err
 
 	// The S3 API method calls above can occasionally return no error (i.e. NoSuchBucket)
 	// after a bucket has been deleted (eventual consistency woes :/), thus, when making extra S3 API calls
@@ -1103,11 +1211,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	// Read the versioning configuration
 
-	versioningResponse, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, error) {
-		return conn.GetBucketVersioningWithContext(ctx, &s3.GetBucketVersioningInput{
-			Bucket: aws.String(d.Id()),
-		})
-	}, s3.ErrCodeNoSuchBucket)
+	versioningResponse, err := versioningCall.output, versioningCall.err
 
 	// The S3 API method calls above can occasionally return no error (i.e. NoSuchBucket)
 	// after a bucket has been deleted (eventual consistency woes :/), thus, when making extra S3 API calls
@@ -1130,11 +1234,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	// Read the acceleration status
 
-	accelerateResponse, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, error) {
-		return conn.GetBucketAccelerateConfigurationWithContext(ctx, &s3.GetBucketAccelerateConfigurationInput{
-			Bucket: aws.String(d.Id()),
-		})
-	}, s3.ErrCodeNoSuchBucket)
+	accelerateResponse, err := accelerateCall.output, accelerateCall.err
 
 	// The S3 API method calls above can occasionally return no error (i.e. NoSuchBucket)
 	// after a bucket has been deleted (eventual consistency woes :/), thus, when making extra S3 API calls
@@ -1156,11 +1256,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	// Read the request payer configuration.
 
-	payerResponse, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, error) {
-		return conn.GetBucketRequestPaymentWithContext(ctx, &s3.GetBucketRequestPaymentInput{
-			Bucket: aws.String(d.Id()),
-		})
-	}, s3.ErrCodeNoSuchBucket)
+	payerResponse, err := payerCall.output, payerCall.err
 
 	// The S3 API method calls above can occasionally return no error (i.e. NoSuchBucket)
 	// after a bucket has been deleted (eventual consistency woes :/), thus, when making extra S3 API calls
@@ -1180,11 +1276,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	// Read the logging configuration if configured outside this resource
-	loggingResponse, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, error) {
-		return conn.GetBucketLoggingWithContext(ctx, &s3.GetBucketLoggingInput{
-			Bucket: aws.String(d.Id()),
-		})
-	}, s3.ErrCodeNoSuchBucket)
+	loggingResponse, err := loggingCall.output, loggingCall.err
 
 	// The S3 API method calls above can occasionally return no error (i.e. NoSuchBucket)
 	// after a bucket has been deleted (eventual consistency woes :/), thus, when making extra S3 API calls
@@ -1209,11 +1301,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	// Read the lifecycle configuration
 
-	lifecycleResponse, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, error) {
-		return conn.GetBucketLifecycleConfigurationWithContext(ctx, &s3.GetBucketLifecycleConfigurationInput{
-			Bucket: aws.String(d.Id()),
-		})
-	}, s3.ErrCodeNoSuchBucket)
+	lifecycleResponse, err := lifecycleCall.output, lifecycleCall.err
 
 	// The S3 API method calls above can occasionally return no error (i.e. NoSuchBucket)
 	// after a bucket has been deleted (eventual consistency woes :/), thus, when making extra S3 API calls
@@ -1238,11 +1326,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	// Read the bucket replication configuration if configured outside this resource
 
-	replicationResponse, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, error) {
-		return conn.GetBucketReplicationWithContext(ctx, &s3.GetBucketReplicationInput{
-			Bucket: aws.String(d.Id()),
-		})
-	}, s3.ErrCodeNoSuchBucket)
+	replicationResponse, err := replicationCall.output, replicationCall.err
 
 	// The S3 API method calls above can occasionally return no error (i.e. NoSuchBucket)
 	// after a bucket has been deleted (eventual consistency woes :/), thus, when making extra S3 API calls
@@ -1268,11 +1352,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	// Read the bucket server side encryption configuration
 
-	encryptionResponse, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, error) {
-		return conn.GetBucketEncryptionWithContext(ctx, &s3.GetBucketEncryptionInput{
-			Bucket: aws.String(d.Id()),
-		})
-	}, s3.ErrCodeNoSuchBucket)
+	encryptionResponse, err := encryptionCall.output, encryptionCall.err
 
 	// The S3 API method calls above can occasionally return no error (i.e. NoSuchBucket)
 	// after a bucket has been deleted (eventual consistency woes :/), thus, when making extra S3 API calls
@@ -1296,11 +1376,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	// Object Lock configuration.
-	resp, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, error) {
-		return conn.GetObjectLockConfigurationWithContext(ctx, &s3.GetObjectLockConfigurationInput{
-			Bucket: aws.String(d.Id()),
-		})
-	}, s3.ErrCodeNoSuchBucket)
+	resp, err := objectLockCall.output, objectLockCall.err
 
 	// The S3 API method calls above can occasionally return no error (i.e. NoSuchBucket)
 	// after a bucket has been deleted (eventual consistency woes :/), thus, when making extra S3 API calls
@@ -1333,21 +1409,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	// Add the region as an attribute
-	discoveredRegion, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, error) {
-		return s3manager.GetBucketRegionWithClient(ctx, conn, d.Id(), func(r *request.Request) {
-			// By default, GetBucketRegion forces virtual host addressing, which
-			// is not compatible with many non-AWS implementations. Instead, pass
-			// the provider s3_force_path_style configuration, which defaults to
-			// false, but allows override.
-			r.Config.S3ForcePathStyle = conn.Config.S3ForcePathStyle
-
-			// By default, GetBucketRegion uses anonymous credentials when doing
-			// a HEAD request to get the bucket region. This breaks in aws-cn regions
-			// when the account doesn't have an ICP license to host public content.
-			// Use the current credentials when getting the bucket region.
-			r.Config.Credentials = conn.Config.Credentials
-		})
-	}, "NotFound")
+	discoveredRegion, err := regionCall.output, regionCall.err
 
 	// The S3 API method calls above can occasionally return no error (i.e. NoSuchBucket)
 	// after a bucket has been deleted (eventual consistency woes :/), thus, when making extra S3 API calls
@@ -1400,10 +1462,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 		d.Set("website_domain", websiteEndpoint.Domain)
 	}
 
-	// Retry due to S3 eventual consistency
-	tagsRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, error) {
-		return BucketListTags(ctx, conn, d.Id())
-	}, s3.ErrCodeNoSuchBucket)
+	tagsRaw, err := tagsCall.output, tagsCall.err
 
 	// The S3 API method calls above can occasionally return no error (i.e. NoSuchBucket)
 	// after a bucket has been deleted (eventual consistency woes :/), thus, when making extra S3 API calls
@@ -1445,6 +1504,37 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	return diags
 }
 
+// bucketReadCall is a single S3 API call made when reading an S3 Bucket.
+type bucketReadCall struct {
+	f      func(context.Context) (interface{}, error)
+	output interface{}
+	err    error
+}
+
+// runBucketReadCalls makes the specified calls with at most n in flight at any one time.
+// Each call's output and error are recorded on the call itself.
+// A NoSuchBucket error from any call cancels the remaining calls and is returned.
+func runBucketReadCalls(ctx context.Context, n int, calls ...*bucketReadCall) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(n)
+
+	for _, call := range calls {
+		call := call
+
+		g.Go(func() error {
+			call.output, call.err = call.f(ctx)
+
+			if tfawserr.ErrCodeEquals(call.err, s3.ErrCodeNoSuchBucket) {
+				return call.err
+			}
+
+			return nil
+		})
+	}
+
+	return g.Wait()
+}
+
 func resourceBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).S3Conn()
 
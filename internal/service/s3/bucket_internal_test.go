@@ -0,0 +1,110 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+func TestRunBucketReadCalls(t *testing.T) {
+	t.Parallel()
+
+	const n = 3
+	var inFlight, maxInFlight int32
+	errEven := errors.New("even")
+
+	// Each call blocks until n calls are in flight, so a serial implementation only
+	// makes progress when the wait times out.
+	full := make(chan struct{})
+	var fullOnce sync.Once
+
+	var calls []*bucketReadCall
+	for i := 0; i < 12; i++ {
+		i := i
+		calls = append(calls, &bucketReadCall{f: func(context.Context) (interface{}, error) {
+			v := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+
+			for {
+				m := atomic.LoadInt32(&maxInFlight)
+				if v <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, v) {
+					break
+				}
+			}
+
+			if v == n {
+				fullOnce.Do(func() { close(full) })
+			}
+
+			select {
+			case <-full:
+			case <-time.After(1 * time.Second):
+			}
+
+			if i%2 == 0 {
+				return nil, errEven
+			}
+
+			return i, nil
+		}})
+	}
+
+	if err := runBucketReadCalls(context.Background(), n, calls...); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := atomic.LoadInt32(&maxInFlight); got != n {
+		t.Errorf("expected %d calls in flight, got %d", n, got)
+	}
+
+	for i, call := range calls {
+		if i%2 == 0 {
+			if call.err != errEven {
+				t.Errorf("call %d: expected error %q, got %v", i, errEven, call.err)
+			}
+			continue
+		}
+
+		if call.err != nil {
+			t.Errorf("call %d: unexpected error: %s", i, call.err)
+		}
+
+		if got, ok := call.output.(int); !ok || got != i {
+			t.Errorf("call %d: expected output %d, got %v", i, i, call.output)
+		}
+	}
+}
+
+func TestRunBucketReadCalls_noSuchBucket(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{})
+
+	// The first call blocks until its context is cancelled.
+	blocked := &bucketReadCall{f: func(ctx context.Context) (interface{}, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}}
+	noSuchBucket := &bucketReadCall{f: func(context.Context) (interface{}, error) {
+		<-started
+		return nil, awserr.New(s3.ErrCodeNoSuchBucket, "The specified bucket does not exist", nil)
+	}}
+
+	err := runBucketReadCalls(context.Background(), 2, blocked, noSuchBucket)
+
+	if !tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		t.Fatalf("expected %s error, got %v", s3.ErrCodeNoSuchBucket, err)
+	}
+
+	if !errors.Is(blocked.err, context.Canceled) {
+		t.Errorf("expected blocked call to be cancelled, got %v", blocked.err)
+	}
+}
@@ -856,8 +856,15 @@ func TestAccS3BucketLifecycleConfiguration_EmptyFilter_NonCurrentVersions(t *tes
 				Config: testAccBucketLifecycleConfigurationConfig_emptyFilterNonCurrentVersions(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filter.0.prefix", ""),
 				),
 			},
+			{
+				Config:   testAccBucketLifecycleConfigurationConfig_emptyFilterNonCurrentVersions(rName),
+				PlanOnly: true,
+			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
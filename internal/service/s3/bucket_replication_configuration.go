@@ -2,6 +2,7 @@ package s3
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -302,6 +303,8 @@ func ResourceBucketReplicationConfiguration() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: resourceBucketReplicationConfigurationCustomizeDiff,
 	}
 }
 
@@ -449,3 +452,32 @@ func resourceBucketReplicationConfigurationDelete(ctx context.Context, d *schema
 
 	return diags
 }
+
+func resourceBucketReplicationConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// This CustomizeDiff acts as a plan-time validation to prevent MalformedXML errors
+	// as S3 Replication Time Control requires both replication_time and metrics to be configured.
+	for i, rule := range diff.Get("rule").([]interface{}) {
+		tfMap, ok := rule.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		destination, ok := tfMap["destination"].([]interface{})
+
+		if !ok || len(destination) == 0 || destination[0] == nil {
+			continue
+		}
+
+		tfMap = destination[0].(map[string]interface{})
+
+		metrics, _ := tfMap["metrics"].([]interface{})
+		replicationTime, _ := tfMap["replication_time"].([]interface{})
+
+		if len(replicationTime) > 0 && replicationTime[0] != nil && (len(metrics) == 0 || metrics[0] == nil) {
+			return fmt.Errorf("rule.%d.destination.0.metrics must be configured when rule.%d.destination.0.replication_time is configured", i, i)
+		}
+	}
+
+	return nil
+}
@@ -228,7 +228,11 @@ func ExpandLifecycleRuleFilter(ctx context.Context, l []interface{}) *s3.Lifecyc
 
 	result := &s3.LifecycleRuleFilter{}
 
+	// An empty filter block i.e. 'filter {}' is equivalent to a filter with an empty prefix,
+	// which is what the S3 API returns; send it explicitly so that the two don't diff.
 	if l[0] == nil {
+		result.Prefix = aws.String("")
+
 		return result
 	}
 
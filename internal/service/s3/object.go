@@ -3,10 +3,15 @@ package s3
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"log"
+	"math"
 	"net/http"
 	"os"
 	"regexp"
@@ -139,6 +144,38 @@ func ResourceObject() *schema.Resource {
 				Optional:     true,
 				Elem:         &schema.Schema{Type: schema.TypeString},
 			},
+			"multipart_upload": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				// The ETag of an object uploaded in parts is not an MD5 digest of its content; use source_hash instead.
+				ConflictsWith: []string{"etag"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"concurrency": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      s3manager.DefaultUploadConcurrency,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						// The S3 maximum part size of 5 GiB doesn't fit in an int on 32-bit platforms.
+						"part_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      s3manager.DefaultUploadPartSize,
+							ValidateFunc: validation.IntBetween(int(s3manager.MinUploadPartSize), math.MaxInt32),
+						},
+					},
+				},
+			},
+			"multipart_upload_source_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"multipart_upload_source_last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"object_lock_legal_hold_status": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -429,7 +466,19 @@ func resourceObjectImport(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Conn()
-	uploader := s3manager.NewUploaderWithClient(conn)
+	uploader := s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		if v, ok := d.GetOk("multipart_upload"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			if v, ok := tfMap["concurrency"].(int); ok && v > 0 {
+				u.Concurrency = v
+			}
+
+			if v, ok := tfMap["part_size"].(int); ok && v > 0 {
+				u.PartSize = int64(v)
+			}
+		}
+	})
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
@@ -441,6 +490,21 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "expanding homedir in source (%s): %s", source, err)
 		}
+		if v, ok := d.GetOk("multipart_upload"); ok && len(v.([]interface{})) > 0 && d.GetRawConfig().GetAttr("source_hash").IsNull() {
+			lastModified, err := objectSourceLastModified(path)
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			hash, err := objectSourceMD5(path)
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			d.Set("multipart_upload_source_hash", hash)
+			d.Set("multipart_upload_source_last_modified", lastModified)
+		}
+
 		file, err := os.Open(path)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "opening S3 object source (%s): %s", path, err)
@@ -582,6 +646,10 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 }
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := diffObjectMultipartUploadSourceHash(d); err != nil {
+		return err
+	}
+
 	if hasObjectContentChanges(d) {
 		return d.SetNewComputed("version_id")
 	}
@@ -594,6 +662,99 @@ func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta
 	return nil
 }
 
+// diffObjectMultipartUploadSourceHash detects changes to source for multipart uploads without a configured source_hash.
+// The ETag of an object uploaded in parts is not an MD5 digest of its content, so it can't detect changes.
+// The digest recorded at apply is compared against source if it already exists; otherwise it's computed at apply.
+// Hashing a large source is slow, so source is only hashed if its path or modification time has changed.
+func diffObjectMultipartUploadSourceHash(d *schema.ResourceDiff) error {
+	if v, ok := d.GetOk("multipart_upload"); !ok || len(v.([]interface{})) == 0 {
+		return nil
+	}
+
+	if !d.GetRawConfig().GetAttr("source_hash").IsNull() {
+		return nil
+	}
+
+	if !d.NewValueKnown("source") {
+		return setObjectMultipartUploadSourceNewComputed(d)
+	}
+
+	source := d.Get("source").(string)
+	if source == "" {
+		return nil
+	}
+
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+	}
+
+	lastModified, err := objectSourceLastModified(path)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		// The source may be created during apply.
+		return setObjectMultipartUploadSourceNewComputed(d)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !d.HasChange("source") && d.Get("multipart_upload_source_hash").(string) != "" && lastModified == d.Get("multipart_upload_source_last_modified").(string) {
+		return nil
+	}
+
+	hash, err := objectSourceMD5(path)
+
+	if err != nil {
+		return err
+	}
+
+	if hash != d.Get("multipart_upload_source_hash").(string) {
+		if err := d.SetNew("multipart_upload_source_hash", hash); err != nil {
+			return err
+		}
+
+		return d.SetNew("multipart_upload_source_last_modified", lastModified)
+	}
+
+	return nil
+}
+
+func setObjectMultipartUploadSourceNewComputed(d *schema.ResourceDiff) error {
+	if err := d.SetNewComputed("multipart_upload_source_hash"); err != nil {
+		return err
+	}
+
+	return d.SetNewComputed("multipart_upload_source_last_modified")
+}
+
+// objectSourceLastModified returns the modification time of the file at path.
+func objectSourceLastModified(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("reading S3 object source (%s): %w", path, err)
+	}
+
+	return info.ModTime().UTC().Format(time.RFC3339Nano), nil
+}
+
+// objectSourceMD5 returns the hex-encoded MD5 digest of the file at path, matching filemd5().
+func objectSourceMD5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("opening S3 object source (%s): %w", path, err)
+	}
+	defer file.Close()
+
+	hash := md5.New() //nolint:gosec // Matches filemd5(), which is what source_hash is documented to be set to.
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("reading S3 object source (%s): %w", path, err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
@@ -607,6 +768,7 @@ func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 		"etag",
 		"kms_key_id",
 		"metadata",
+		"multipart_upload_source_hash",
 		"server_side_encryption",
 		"source",
 		"source_hash",
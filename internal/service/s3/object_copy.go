@@ -58,6 +58,27 @@ func ResourceObjectCopy() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"checksum_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+			},
+			"checksum_crc32": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_crc32c": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content_disposition": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -313,10 +334,17 @@ func resourceObjectCopyRead(ctx context.Context, d *schema.ResourceData, meta in
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
-	resp, err := conn.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+	input := &s3.HeadObjectInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
-	})
+	}
+
+	// Checksums are only returned on request, and may require kms:Decrypt for SSE-KMS encrypted objects.
+	if _, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumMode = aws.String(s3.ChecksumModeEnabled)
+	}
+
+	resp, err := conn.HeadObjectWithContext(ctx, input)
 
 	if !d.IsNewResource() && tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) {
 		log.Printf("[WARN] S3 Object (%s) not found, removing from state", d.Id())
@@ -336,6 +364,10 @@ func resourceObjectCopyRead(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.Set("bucket_key_enabled", resp.BucketKeyEnabled)
 	d.Set("cache_control", resp.CacheControl)
+	d.Set("checksum_crc32", resp.ChecksumCRC32)
+	d.Set("checksum_crc32c", resp.ChecksumCRC32C)
+	d.Set("checksum_sha1", resp.ChecksumSHA1)
+	d.Set("checksum_sha256", resp.ChecksumSHA256)
 	d.Set("content_disposition", resp.ContentDisposition)
 	d.Set("content_encoding", resp.ContentEncoding)
 	d.Set("content_language", resp.ContentLanguage)
@@ -422,6 +454,7 @@ func resourceObjectCopyUpdate(ctx context.Context, d *schema.ResourceData, meta
 		"bucket",
 		"bucket_key_enabled",
 		"cache_control",
+		"checksum_algorithm",
 		"content_disposition",
 		"content_encoding",
 		"content_language",
@@ -500,6 +533,11 @@ func resourceObjectCopyDoCopy(ctx context.Context, d *schema.ResourceData, meta
 		input.ACL = aws.String(v.(string))
 	}
 
+	// Unlike PutObject, CopyObject sends x-amz-checksum-algorithm and S3 calculates the checksum of the copy.
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumAlgorithm = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("bucket_key_enabled"); ok {
 		input.BucketKeyEnabled = aws.Bool(v.(bool))
 	}
@@ -648,6 +686,10 @@ func resourceObjectCopyDoCopy(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("customer_key_md5", output.SSECustomerKeyMD5)
 
 	if output.CopyObjectResult != nil {
+		d.Set("checksum_crc32", output.CopyObjectResult.ChecksumCRC32)
+		d.Set("checksum_crc32c", output.CopyObjectResult.ChecksumCRC32C)
+		d.Set("checksum_sha1", output.CopyObjectResult.ChecksumSHA1)
+		d.Set("checksum_sha256", output.CopyObjectResult.ChecksumSHA256)
 		d.Set("etag", strings.Trim(aws.StringValue(output.CopyObjectResult.ETag), `"`))
 		d.Set("last_modified", flattenObjectDate(output.CopyObjectResult.LastModified))
 	}
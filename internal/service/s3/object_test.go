@@ -2,6 +2,7 @@ package s3_test
 
 import (
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"fmt"
 	"io"
@@ -9,6 +10,7 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -145,6 +147,71 @@ func TestAccS3Object_content(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_multipartUpload(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2, obj3 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	content := strings.Repeat("a", 6*1024*1024)
+	source := testAccObjectCreateTempFile(t, content)
+	defer os.Remove(source)
+	sourceHash := fmt.Sprintf("%x", md5.Sum([]byte(content))) //nolint:gosec
+	updatedContent := strings.Repeat("b", 6*1024*1024)
+	updatedSourceHash := fmt.Sprintf("%x", md5.Sum([]byte(updatedContent))) //nolint:gosec
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_multipartUpload(rName, source, 5242880, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload.0.concurrency", "2"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload.0.part_size", "5242880"),
+					// ETag of a multipart upload is "<md5 of part md5s>-<part count>".
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`-2$`)),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload_source_hash", sourceHash),
+					resource.TestCheckResourceAttrSet(resourceName, "multipart_upload_source_last_modified"),
+					resource.TestCheckNoResourceAttr(resourceName, "source_hash"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl", "force_destroy", "multipart_upload", "multipart_upload_source_hash", "multipart_upload_source_last_modified", "source", "source_hash"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+			{
+				Config: testAccObjectConfig_multipartUpload(rName, source, 6291456, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIdEquals(&obj2, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload.0.concurrency", "1"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload.0.part_size", "6291456"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(source, []byte(updatedContent), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectConfig_multipartUpload(rName, source, 6291456, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj3),
+					testAccCheckObjectVersionIdDiffers(&obj3, &obj2),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload_source_hash", updatedSourceHash),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_etagEncryption(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -1658,6 +1725,25 @@ resource "aws_s3_object" "object" {
 `, rName, content)
 }
 
+func testAccObjectConfig_multipartUpload(rName, source string, partSize, concurrency int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
+  source = %[2]q
+
+  multipart_upload {
+    part_size   = %[3]d
+    concurrency = %[4]d
+  }
+}
+`, rName, source, partSize, concurrency)
+}
+
 func testAccObjectConfig_etagEncryption(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -55,6 +55,11 @@ func SubscriptionProtocol_Values() []string {
 	}
 }
 
+const (
+	// The password of an HTTP(S) subscription endpoint with basic authentication is masked by ListSubscriptionsByTopic.
+	subscriptionEndpointMaskedPassword = "****"
+)
+
 const (
 	SubscriptionAttributeNameConfirmationWasAuthenticated = "ConfirmationWasAuthenticated"
 	SubscriptionAttributeNameDeliveryPolicy               = "DeliveryPolicy"
@@ -66,6 +71,7 @@ const (
 	SubscriptionAttributeNameProtocol                     = "Protocol"
 	SubscriptionAttributeNameRawMessageDelivery           = "RawMessageDelivery"
 	SubscriptionAttributeNameRedrivePolicy                = "RedrivePolicy"
+	SubscriptionAttributeNameReplayPolicy                 = "ReplayPolicy"
 	SubscriptionAttributeNameSubscriptionARN              = "SubscriptionArn"
 	SubscriptionAttributeNameSubscriptionRoleARN          = "SubscriptionRoleArn"
 	SubscriptionAttributeNameTopicARN                     = "TopicArn"
@@ -75,9 +81,11 @@ const (
 	TopicAttributeNameApplicationFailureFeedbackRoleARN    = "ApplicationFailureFeedbackRoleArn"
 	TopicAttributeNameApplicationSuccessFeedbackRoleARN    = "ApplicationSuccessFeedbackRoleArn"
 	TopicAttributeNameApplicationSuccessFeedbackSampleRate = "ApplicationSuccessFeedbackSampleRate"
+	TopicAttributeNameArchivePolicy                        = "ArchivePolicy"
 	TopicAttributeNameContentBasedDeduplication            = "ContentBasedDeduplication"
 	TopicAttributeNameDeliveryPolicy                       = "DeliveryPolicy"
 	TopicAttributeNameDisplayName                          = "DisplayName"
+	TopicAttributeNameFIFOThroughputScope                  = "FifoThroughputScope"
 	TopicAttributeNameFIFOTopic                            = "FifoTopic"
 	TopicAttributeNameFirehoseFailureFeedbackRoleARN       = "FirehoseFailureFeedbackRoleArn"
 	TopicAttributeNameFirehoseSuccessFeedbackRoleARN       = "FirehoseSuccessFeedbackRoleArn"
@@ -115,6 +123,18 @@ func SubscriptionFilterPolicyScope_Values() []string {
 	}
 }
 
+const (
+	TopicFIFOThroughputScopeMessageGroup = "MessageGroup"
+	TopicFIFOThroughputScopeTopic        = "Topic"
+)
+
+func TopicFIFOThroughputScope_Values() []string {
+	return []string{
+		TopicFIFOThroughputScopeMessageGroup,
+		TopicFIFOThroughputScopeTopic,
+	}
+}
+
 const (
 	TopicTracingConfigActive      = "Active"
 	TopicTracingConfigPassThrough = "PassThrough"
@@ -0,0 +1,6 @@
+package sns
+
+// Exports for use in tests only.
+var (
+	SubscriptionEndpointsEqual = subscriptionEndpointsEqual
+)
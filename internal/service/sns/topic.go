@@ -42,6 +42,22 @@ var (
 			Optional:     true,
 			ValidateFunc: validation.IntBetween(0, 100),
 		},
+		"archive_policy": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				// A removed archive policy may be read back as an empty policy.
+				if old == "{}" && new == "" {
+					return true
+				}
+				return verify.SuppressEquivalentJSONDiffs(k, old, new, d)
+			},
+			StateFunc: func(v interface{}) string {
+				json, _ := structure.NormalizeJsonString(v)
+				return json
+			},
+		},
 		"arn": {
 			Type:     schema.TypeString,
 			Computed: true,
@@ -65,6 +81,12 @@ var (
 			Type:     schema.TypeString,
 			Optional: true,
 		},
+		"fifo_throughput_scope": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(TopicFIFOThroughputScope_Values(), false),
+		},
 		"fifo_topic": {
 			Type:     schema.TypeBool,
 			Optional: true,
@@ -185,10 +207,12 @@ var (
 		"application_failure_feedback_role_arn":    TopicAttributeNameApplicationFailureFeedbackRoleARN,
 		"application_success_feedback_role_arn":    TopicAttributeNameApplicationSuccessFeedbackRoleARN,
 		"application_success_feedback_sample_rate": TopicAttributeNameApplicationSuccessFeedbackSampleRate,
+		"archive_policy":                        TopicAttributeNameArchivePolicy,
 		"arn":                                   TopicAttributeNameTopicARN,
 		"content_based_deduplication":           TopicAttributeNameContentBasedDeduplication,
 		"delivery_policy":                       TopicAttributeNameDeliveryPolicy,
 		"display_name":                          TopicAttributeNameDisplayName,
+		"fifo_throughput_scope":                 TopicAttributeNameFIFOThroughputScope,
 		"fifo_topic":                            TopicAttributeNameFIFOTopic,
 		"firehose_failure_feedback_role_arn":    TopicAttributeNameFirehoseFailureFeedbackRoleARN,
 		"firehose_success_feedback_role_arn":    TopicAttributeNameFirehoseSuccessFeedbackRoleARN,
@@ -456,6 +480,14 @@ func resourceTopicCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 		return fmt.Errorf("content-based deduplication can only be set for FIFO topics")
 	}
 
+	if !fifoTopic && diff.Get("archive_policy").(string) != "" {
+		return fmt.Errorf("archive_policy can only be set for FIFO topics")
+	}
+
+	if !fifoTopic && !diff.GetRawConfig().GetAttr("fifo_throughput_scope").IsNull() {
+		return fmt.Errorf("fifo_throughput_scope can only be set for FIFO topics")
+	}
+
 	return nil
 }
 
@@ -480,6 +512,12 @@ func putTopicAttribute(ctx context.Context, conn *sns.SNS, arn string, name, val
 	const (
 		topicPutAttributeTimeout = 2 * time.Minute
 	)
+
+	// An archive policy is removed by setting an empty policy.
+	if name == TopicAttributeNameArchivePolicy && value == "" {
+		value = "{}"
+	}
+
 	input := &sns.SetTopicAttributesInput{
 		AttributeName:  aws.String(name),
 		AttributeValue: aws.String(value),
@@ -7,10 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -97,6 +99,12 @@ var (
 			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 		},
+		"replay_policy": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+		},
 		"subscription_role_arn": {
 			Type:         schema.TypeString,
 			Optional:     true,
@@ -122,6 +130,7 @@ var (
 		"protocol":                       SubscriptionAttributeNameProtocol,
 		"raw_message_delivery":           SubscriptionAttributeNameRawMessageDelivery,
 		"redrive_policy":                 SubscriptionAttributeNameRedrivePolicy,
+		"replay_policy":                  SubscriptionAttributeNameReplayPolicy,
 		"subscription_role_arn":          SubscriptionAttributeNameSubscriptionRoleARN,
 		"topic_arn":                      SubscriptionAttributeNameTopicARN,
 	}, subscriptionSchema).WithMissingSetToNil("*")
@@ -159,13 +168,15 @@ func resourceTopicSubscriptionCreate(ctx context.Context, d *schema.ResourceData
 	delete(attributes, SubscriptionAttributeNameProtocol)
 	delete(attributes, SubscriptionAttributeNameTopicARN)
 
+	endpoint := d.Get("endpoint").(string)
 	protocol := d.Get("protocol").(string)
+	topicARN := d.Get("topic_arn").(string)
 	input := &sns.SubscribeInput{
 		Attributes:            aws.StringMap(attributes),
-		Endpoint:              aws.String(d.Get("endpoint").(string)),
+		Endpoint:              aws.String(endpoint),
 		Protocol:              aws.String(protocol),
 		ReturnSubscriptionArn: aws.Bool(true), // even if not confirmed, will get ARN
-		TopicArn:              aws.String(d.Get("topic_arn").(string)),
+		TopicArn:              aws.String(topicARN),
 	}
 
 	output, err := conn.SubscribeWithContext(ctx, input)
@@ -174,7 +185,25 @@ func resourceTopicSubscriptionCreate(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("creating SNS Topic Subscription: %s", err)
 	}
 
-	d.SetId(aws.StringValue(output.SubscriptionArn))
+	subscriptionARN := aws.StringValue(output.SubscriptionArn)
+
+	// An existing subscription that is pending confirmation can be returned as "pending confirmation"
+	// instead of its ARN. The ARN is the only stable identifier, so look it up on the topic.
+	if !arn.IsARN(subscriptionARN) {
+		subscription, err := findSubscriptionByTopicARNEndpointAndProtocol(ctx, conn, topicARN, endpoint, protocol)
+
+		if err != nil {
+			return diag.Errorf("reading SNS Topic (%s) Subscription (%s): %s", topicARN, endpoint, err)
+		}
+
+		subscriptionARN = aws.StringValue(subscription.SubscriptionArn)
+
+		if !arn.IsARN(subscriptionARN) {
+			return diag.Errorf("SNS Topic (%s) Subscription (%s) is pending confirmation and has no ARN, confirm the subscription and import it", topicARN, endpoint)
+		}
+	}
+
+	d.SetId(subscriptionARN)
 
 	waitForConfirmation := true
 
@@ -352,6 +381,81 @@ func FindSubscriptionAttributesByARN(ctx context.Context, conn *sns.SNS, arn str
 	return aws.StringValueMap(output.Attributes), nil
 }
 
+func findSubscriptionByTopicARNEndpointAndProtocol(ctx context.Context, conn *sns.SNS, topicARN, endpoint, protocol string) (*sns.Subscription, error) {
+	input := &sns.ListSubscriptionsByTopicInput{
+		TopicArn: aws.String(topicARN),
+	}
+	var result *sns.Subscription
+
+	err := conn.ListSubscriptionsByTopicPagesWithContext(ctx, input, func(page *sns.ListSubscriptionsByTopicOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Subscriptions {
+			if v == nil || !subscriptionEndpointsEqual(aws.StringValue(v.Endpoint), endpoint) || aws.StringValue(v.Protocol) != protocol {
+				continue
+			}
+
+			result = v
+
+			// Prefer a subscription with an ARN over one that is still pending confirmation.
+			if arn.IsARN(aws.StringValue(v.SubscriptionArn)) {
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, sns.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+// subscriptionEndpointsEqual returns whether a subscription endpoint returned by ListSubscriptionsByTopic
+// matches the configured endpoint. The password of an HTTP(S) endpoint with basic authentication is
+// masked in the listed endpoint.
+func subscriptionEndpointsEqual(listed, configured string) bool {
+	if listed == configured {
+		return true
+	}
+
+	l, err := url.Parse(listed)
+	if err != nil {
+		return false
+	}
+
+	c, err := url.Parse(configured)
+	if err != nil {
+		return false
+	}
+
+	lp, lok := l.User.Password()
+	_, cok := c.User.Password()
+
+	if !lok || !cok || lp != subscriptionEndpointMaskedPassword || l.User.Username() != c.User.Username() {
+		return false
+	}
+
+	l.User = c.User
+
+	return l.String() == c.String()
+}
+
 func statusSubscriptionPendingConfirmation(ctx context.Context, conn *sns.SNS, arn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindSubscriptionAttributesByARN(ctx, conn, arn)
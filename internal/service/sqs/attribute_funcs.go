@@ -2,6 +2,7 @@ package sqs
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,8 @@ type queueAttributeHandler struct {
 	AttributeName string
 	SchemaKey     string
 	ToSet         func(string, string) (string, error)
+	// RetryableErrorMessage, if set, is the SetQueueAttributes InvalidAttributeValue error message retried until the attribute propagation timeout.
+	RetryableErrorMessage string
 }
 
 func (h *queueAttributeHandler) Upsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -32,14 +35,29 @@ func (h *queueAttributeHandler) Upsert(ctx context.Context, d *schema.ResourceDa
 	attributes := map[string]string{
 		h.AttributeName: attrValue,
 	}
-	url := d.Get("queue_url").(string)
+	url, err := queueURLFromNameOrURL(ctx, conn, d.Get("queue_url").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	input := &sqs.SetQueueAttributesInput{
 		Attributes: aws.StringMap(attributes),
 		QueueUrl:   aws.String(url),
 	}
 
 	log.Printf("[DEBUG] Setting SQS Queue attributes: %s", input)
-	_, err = conn.SetQueueAttributesWithContext(ctx, input)
+	_, err = tfresource.RetryWhen(ctx, queueAttributePropagationTimeout,
+		func() (interface{}, error) {
+			return conn.SetQueueAttributesWithContext(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if h.RetryableErrorMessage != "" && tfawserr.ErrMessageContains(err, errCodeInvalidAttributeValue, h.RetryableErrorMessage) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
 
 	if err != nil {
 		return diag.Errorf("setting SQS Queue (%s) attribute (%s): %s", url, h.AttributeName, err)
@@ -115,3 +133,24 @@ func (h *queueAttributeHandler) Delete(ctx context.Context, d *schema.ResourceDa
 
 	return nil
 }
+
+// queueURLFromNameOrURL returns the URL of the SQS queue identified by either its URL or its name.
+func queueURLFromNameOrURL(ctx context.Context, conn *sqs.SQS, v string) (string, error) {
+	if isQueueURL(v) {
+		return v, nil
+	}
+
+	output, err := conn.GetQueueUrlWithContext(ctx, &sqs.GetQueueUrlInput{
+		QueueName: aws.String(v),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("reading SQS Queue (%s) URL: %w", v, err)
+	}
+
+	if output == nil || output.QueueUrl == nil {
+		return "", fmt.Errorf("reading SQS Queue (%s) URL: empty result", v)
+	}
+
+	return aws.StringValue(output.QueueUrl), nil
+}
@@ -4,6 +4,16 @@ const (
 	FIFOQueueNameSuffix = ".fifo"
 )
 
+const (
+	errCodeInvalidAttributeValue = "InvalidAttributeValue"
+)
+
+const (
+	// The queue on the other side of the redrive relationship has not yet propagated.
+	errMessageDeadLetterTargetDoesNotExist = "Dead letter target does not exist"
+	errMessageSourceQueueDoesNotExist      = "does not exist"
+)
+
 const (
 	DefaultQueueDelaySeconds                  = 0
 	DefaultQueueKMSDataKeyReusePeriodSeconds  = 300
@@ -3,4 +3,6 @@ package sqs
 // Exports for use in tests only.
 var (
 	QueueDeletedTimeout = queueDeletedTimeout
+
+	SuppressEquivalentQueueURLs = suppressEquivalentQueueURLs
 )
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // QueueNameFromURL returns the SQS queue name from the specified URL.
@@ -23,3 +25,24 @@ func QueueNameFromURL(u string) (string, error) {
 
 	return parts[2], nil
 }
+
+func isQueueURL(v string) bool {
+	u, err := url.Parse(v)
+
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
+
+// suppressEquivalentQueueURLs suppresses differences between a queue's URL in state and its name in configuration.
+func suppressEquivalentQueueURLs(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+
+	if old == "" || isQueueURL(new) {
+		return false
+	}
+
+	name, err := QueueNameFromURL(old)
+
+	return err == nil && name == new
+}
@@ -62,3 +62,57 @@ func TestQueueNameFromURL(t *testing.T) {
 		})
 	}
 }
+
+func TestSuppressEquivalentQueueURLs(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Expected bool
+	}{
+		{
+			Name:     "same URL",
+			Old:      "https://sqs.us-west-2.amazonaws.com/123456789012/queueName", //lintignore:AWSAT003
+			New:      "https://sqs.us-west-2.amazonaws.com/123456789012/queueName", //lintignore:AWSAT003
+			Expected: true,
+		},
+		{
+			Name:     "different URL",
+			Old:      "https://sqs.us-west-2.amazonaws.com/123456789012/queueName",  //lintignore:AWSAT003
+			New:      "https://sqs.us-west-2.amazonaws.com/123456789012/queueName2", //lintignore:AWSAT003
+			Expected: false,
+		},
+		{
+			Name:     "URL and matching name",
+			Old:      "https://sqs.us-west-2.amazonaws.com/123456789012/queueName", //lintignore:AWSAT003
+			New:      "queueName",
+			Expected: true,
+		},
+		{
+			Name:     "URL and different name",
+			Old:      "https://sqs.us-west-2.amazonaws.com/123456789012/queueName", //lintignore:AWSAT003
+			New:      "queueName2",
+			Expected: false,
+		},
+		{
+			Name:     "new resource",
+			New:      "queueName",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfsqs.SuppressEquivalentQueueURLs("queue_url", testCase.Old, testCase.New, nil)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -43,9 +43,10 @@ func ResourceQueuePolicy() *schema.Resource {
 				},
 			},
 			"queue_url": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentQueueURLs,
 			},
 		},
 	}
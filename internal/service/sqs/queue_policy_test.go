@@ -47,6 +47,34 @@ func TestAccSQSQueuePolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueuePolicy_queueName(t *testing.T) {
+	ctx := acctest.Context(t)
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue_policy.test"
+	queueResourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueuePolicyConfig_queueName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, queueResourceName, &queueAttributes),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_url", queueResourceName, "url"),
+				),
+			},
+			{
+				Config:   testAccQueuePolicyConfig_queueName(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccSQSQueuePolicy_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var queueAttributes map[string]string
@@ -160,6 +188,30 @@ POLICY
 `, rName)
 }
 
+func testAccQueuePolicyConfig_queueName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue_policy" "test" {
+  queue_url = aws_sqs_queue.test.name
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": "*",
+    "Action": "sqs:*",
+    "Resource": "${aws_sqs_queue.test.arn}"
+  }]
+}
+POLICY
+}
+`, rName)
+}
+
 func testAccQueuePolicyConfig_updated(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {
@@ -18,14 +18,16 @@ func ResourceQueueRedriveAllowPolicy() *schema.Resource {
 			}
 			return new, nil
 		},
+		RetryableErrorMessage: errMessageSourceQueueDoesNotExist,
 	}
 
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"queue_url": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentQueueURLs,
 			},
 			"redrive_allow_policy": {
 				Type:         schema.TypeString,